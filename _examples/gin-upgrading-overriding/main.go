@@ -1,10 +1,18 @@
 package main
 
-import "github.com/gin-gonic/gin/binding"
+import (
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/adapters"
+)
 
 func main() {
+	validate := validator.New()
+	validate.SetTagName("binding")
 
-	binding.Validator = new(defaultValidator)
+	// add any custom validations etc. here
+
+	binding.Validator = adapters.Gin(validate)
 
 	// regular gin logic
 }
@@ -2,8 +2,8 @@ package main
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/go-playground/locales/en"
@@ -11,12 +11,16 @@ import (
 	"github.com/go-playground/locales/zh_Hant_TW"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/httpx"
 	en_translations "github.com/go-playground/validator/v10/translations/en"
 	zh_translations "github.com/go-playground/validator/v10/translations/zh"
 	zh_tw_translations "github.com/go-playground/validator/v10/translations/zh_tw"
 )
 
-var uni *ut.UniversalTranslator
+type User struct {
+	FirstName string `json:"first_name" validate:"required"`
+	LastName  string `json:"last_name" validate:"required"`
+}
 
 // This example showcases how to use the Validator and UniversalTranslator with both Simplified and Traditional Chinese languages.
 // To run the example:
@@ -24,11 +28,14 @@ var uni *ut.UniversalTranslator
 // Step 2 - Simplified Chinese: curl -d '{"first_name":"foo"}' -H "Accept-Language: zh" -H "Content-Type: application/json" -X POST http://localhost:8081/users
 // Step 3 - Traditional Chinese: curl -d '{"first_name":"foo"}' -H "Accept-Language: zh-Hant-TW" -H "Content-Type: application/json" -X POST http://localhost:8081/users
 func main() {
-	validate := validator.New()
 	en := en.New()
-	uni = ut.New(en, en, zh.New(), zh_Hant_TW.New())
+	uni := ut.New(en, en, zh.New(), zh_Hant_TW.New())
+
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
 
-	validate = validator.New()
 	enTrans, _ := uni.GetTranslator("en")
 	en_translations.RegisterDefaultTranslations(validate, enTrans)
 	zhTrans, _ := uni.GetTranslator("zh")
@@ -36,27 +43,12 @@ func main() {
 	zhHantTrans, _ := uni.GetTranslator("zh_Hant_TW")
 	zh_tw_translations.RegisterDefaultTranslations(validate, zhHantTrans)
 
-	type User struct {
-		FirstName string `json:"first_name" validate:"required"`
-		LastName  string `json:"last_name" validate:"required"`
-	}
-
-	http.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
-		// ... fill user value
-		var user User
-
-		// Header Accept-Language value is en or zh
-		trans, _ := uni.GetTranslator(strings.Replace(r.Header.Get("Accept-Language"), "-", "_", -1))
-		if err := validate.Struct(&user); err != nil {
-			var errs validator.ValidationErrors
-			var httpErrors []validator.ValidationErrorsTranslations
-			if errors.As(err, &errs) {
-				httpErrors = append(httpErrors, errs.Translate(trans))
-			}
-			r, _ := json.Marshal(httpErrors)
-			w.Write(r)
-		}
-	})
+	// httpx.Bind decodes the body, validates it and, on failure, responds with a 422
+	// problem document translated using the negotiated Accept-Language.
+	http.Handle("/users", httpx.Bind(validate, func(w http.ResponseWriter, r *http.Request, user *User) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(user)
+	}, httpx.WithTranslator(uni)))
 
 	http.ListenAndServe(":8081", nil)
 }
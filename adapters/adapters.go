@@ -0,0 +1,164 @@
+// Package adapters exposes a *validator.Validate through the method sets web
+// frameworks expect of a pluggable validator, without depending on any of them.
+//
+// Gin:
+//
+//	binding.Validator = adapters.Gin(validate)
+//
+// Echo:
+//
+//	e.Validator = adapters.Echo(validate)
+//
+// Fiber, Chi or plain net/http, where the caller invokes validation itself:
+//
+//	validateFn := adapters.Func(validate)
+//	if err := validateFn(&body); err != nil { ... }
+package adapters
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// GinValidator satisfies gin's binding.StructValidator interface.
+type GinValidator struct {
+	v *validator.Validate
+}
+
+// Gin returns an adapter that can be assigned to gin's binding.Validator.
+//
+// Like gin's own default validator, non-struct values are ignored and the
+// elements of slices and arrays are validated individually.
+//
+// NOTE: gin users usually also call validate.SetTagName("binding").
+func Gin(v *validator.Validate) *GinValidator {
+	return &GinValidator{v: v}
+}
+
+// ValidateStruct validates obj, which may be a struct, a pointer to one or a
+// slice or array of them.
+func (g *GinValidator) ValidateStruct(obj any) error {
+	return validate(context.Background(), g.v, obj)
+}
+
+// Engine returns the underlying *validator.Validate.
+func (g *GinValidator) Engine() any {
+	return g.v
+}
+
+// EchoValidator satisfies echo's Validator interface.
+type EchoValidator struct {
+	v *validator.Validate
+}
+
+// Echo returns an adapter that can be assigned to an echo.Echo's Validator field.
+func Echo(v *validator.Validate) *EchoValidator {
+	return &EchoValidator{v: v}
+}
+
+// Validate validates i, which may be a struct, a pointer to one or a slice or
+// array of them.
+func (e *EchoValidator) Validate(i any) error {
+	return validate(context.Background(), e.v, i)
+}
+
+// Func returns a validation function with the same semantics as the other
+// adapters, for frameworks such as Fiber or Chi that leave validation to the
+// handler.
+func Func(v *validator.Validate) func(any) error {
+	return func(i any) error {
+		return validate(context.Background(), v, i)
+	}
+}
+
+// FuncCtx is the same as Func but passes ctx through to the validator, allowing
+// request scoped values to reach FuncCtx validations.
+func FuncCtx(v *validator.Validate) func(context.Context, any) error {
+	return func(ctx context.Context, i any) error {
+		return validate(ctx, v, i)
+	}
+}
+
+// SliceValidationError is returned when validating a slice or array and one or
+// more of its elements fail validation.
+type SliceValidationError []error
+
+// Error returns the element errors prefixed with their index, one per line.
+func (err SliceValidationError) Error() string {
+	var b strings.Builder
+
+	for i := 0; i < len(err); i++ {
+		if err[i] == nil {
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteByte('[')
+		b.WriteString(strconv.Itoa(i))
+		b.WriteString("]: ")
+		b.WriteString(err[i].Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the element errors so errors.As can reach the underlying
+// validator.ValidationErrors.
+func (err SliceValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(err))
+	for _, e := range err {
+		if e != nil {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
+func validate(ctx context.Context, v *validator.Validate, obj any) error {
+	if obj == nil {
+		return nil
+	}
+
+	val := reflect.ValueOf(obj)
+
+	switch val.Kind() {
+	case reflect.Ptr:
+		if val.IsNil() {
+			return nil
+		}
+
+		if val.Elem().Kind() != reflect.Struct {
+			return validate(ctx, v, val.Elem().Interface())
+		}
+		return v.StructCtx(ctx, obj)
+
+	case reflect.Struct:
+		return v.StructCtx(ctx, obj)
+
+	case reflect.Slice, reflect.Array:
+		var errs SliceValidationError
+
+		for i := 0; i < val.Len(); i++ {
+			err := validate(ctx, v, val.Index(i).Interface())
+			if err != nil {
+				if errs == nil {
+					errs = make(SliceValidationError, val.Len())
+				}
+				errs[i] = err
+			}
+		}
+
+		if errs == nil {
+			return nil
+		}
+		return errs
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/go-playground/assert/v2"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// method sets as declared by gin's binding.StructValidator and echo's Validator
+type ginStructValidator interface {
+	ValidateStruct(any) error
+	Engine() any
+}
+
+type echoValidator interface {
+	Validate(i any) error
+}
+
+var (
+	_ ginStructValidator = Gin(nil)
+	_ echoValidator      = Echo(nil)
+)
+
+type user struct {
+	Name string `binding:"required"`
+}
+
+func TestGin(t *testing.T) {
+	v := validator.New()
+	v.SetTagName("binding")
+
+	g := Gin(v)
+	Equal(t, g.Engine(), v)
+
+	Equal(t, g.ValidateStruct(nil), nil)
+	Equal(t, g.ValidateStruct(1), nil)
+	Equal(t, g.ValidateStruct(map[string]string{}), nil)
+	Equal(t, g.ValidateStruct((*user)(nil)), nil)
+	Equal(t, g.ValidateStruct(&user{Name: "joey"}), nil)
+
+	err := g.ValidateStruct(user{})
+	NotEqual(t, err, nil)
+
+	var verrs validator.ValidationErrors
+	Equal(t, errors.As(err, &verrs), true)
+	Equal(t, verrs[0].Namespace(), "user.Name")
+
+	users := []user{{Name: "joey"}, {}, {Name: "zoey"}, {}}
+	err = g.ValidateStruct(&users)
+	NotEqual(t, err, nil)
+
+	var serr SliceValidationError
+	Equal(t, errors.As(err, &serr), true)
+	Equal(t, len(serr), 4)
+	Equal(t, serr[0], nil)
+	NotEqual(t, serr[1], nil)
+	Equal(t, serr[2], nil)
+	NotEqual(t, serr[3], nil)
+	Equal(t, errors.As(err, &verrs), true)
+	Equal(t, err.Error(), "[1]: Key: 'user.Name' Error:Field validation for 'Name' failed on the 'required' tag\n[3]: Key: 'user.Name' Error:Field validation for 'Name' failed on the 'required' tag")
+
+	Equal(t, g.ValidateStruct([]*user{{Name: "joey"}, nil}), nil)
+}
+
+func TestEcho(t *testing.T) {
+	v := validator.New()
+	v.SetTagName("binding")
+
+	e := Echo(v)
+	Equal(t, e.Validate(&user{Name: "joey"}), nil)
+	NotEqual(t, e.Validate(&user{}), nil)
+	NotEqual(t, e.Validate([1]user{}), nil)
+	Equal(t, e.Validate("not a struct"), nil)
+}
+
+func TestFunc(t *testing.T) {
+	v := validator.New()
+	v.SetTagName("binding")
+
+	fn := Func(v)
+	Equal(t, fn(&user{Name: "joey"}), nil)
+	NotEqual(t, fn(&user{}), nil)
+
+	type ctxKey struct{}
+
+	err := v.RegisterValidationCtx("tenant", func(ctx context.Context, fl validator.FieldLevel) bool {
+		return fl.Field().String() == ctx.Value(ctxKey{})
+	})
+	Equal(t, err, nil)
+
+	type tenantScoped struct {
+		Tenant string `binding:"tenant"`
+	}
+
+	fnCtx := FuncCtx(v)
+	ctx := context.WithValue(context.Background(), ctxKey{}, "acme")
+	Equal(t, fnCtx(ctx, tenantScoped{Tenant: "acme"}), nil)
+	NotEqual(t, fnCtx(ctx, tenantScoped{Tenant: "other"}), nil)
+}
@@ -20,7 +20,6 @@ import (
 	"slices"
 	"strconv"
 	"strings"
-	"sync"
 	"syscall"
 	"time"
 	"unicode/utf8"
@@ -266,9 +265,6 @@ var (
 )
 
 var (
-	oneofValsCache       = map[string][]string{}
-	oneofValsCacheRWLock = sync.RWMutex{}
-
 	// BCP47 language tag
 	// according to https://www.rfc-editor.org/rfc/bcp/bcp47.txt
 	bcp47LanguageTagRe = regexp.MustCompile(strings.Join([]string{
@@ -297,22 +293,6 @@ var (
 	}, ""))
 )
 
-func parseOneOfParam2(s string) []string {
-	oneofValsCacheRWLock.RLock()
-	vals, ok := oneofValsCache[s]
-	oneofValsCacheRWLock.RUnlock()
-	if !ok {
-		oneofValsCacheRWLock.Lock()
-		vals = splitParamsRegex().FindAllString(s, -1)
-		for i := 0; i < len(vals); i++ {
-			vals[i] = strings.ReplaceAll(vals[i], "'", "")
-		}
-		oneofValsCache[s] = vals
-		oneofValsCacheRWLock.Unlock()
-	}
-	return vals
-}
-
 func isURLEncoded(fl FieldLevel) bool {
 	return uRLEncodedRegex().MatchString(fl.Field().String())
 }
@@ -326,8 +306,6 @@ func isHTML(fl FieldLevel) bool {
 }
 
 func isOneOf(fl FieldLevel) bool {
-	vals := parseOneOfParam2(fl.Param())
-
 	field := fl.Field()
 
 	var v string
@@ -342,12 +320,12 @@ func isOneOf(fl FieldLevel) bool {
 		panic(fmt.Sprintf("Bad field type %s", field.Type()))
 	}
 
-	return slices.Contains(vals, v)
+	return ParsedParam(fl).Contains(v)
 }
 
 // isOneOfCI is the validation function for validating if the current field's value is one of the provided string values (case insensitive).
 func isOneOfCI(fl FieldLevel) bool {
-	vals := parseOneOfParam2(fl.Param())
+	vals := ParsedParam(fl).Values()
 	field := fl.Field()
 
 	if field.Kind() != reflect.String {
@@ -1418,39 +1396,39 @@ func isEqField(fl FieldLevel) bool {
 // isEq is the validation function for validating if the current field's value is equal to the param's value.
 func isEq(fl FieldLevel) bool {
 	field := fl.Field()
-	param := fl.Param()
+	param := ParsedParam(fl)
 
 	switch field.Kind() {
 	case reflect.String:
-		return field.String() == param
+		return field.String() == param.String()
 
 	case reflect.Slice, reflect.Map, reflect.Array:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(field.Len()) == p
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p := asIntFromType(field.Type(), param)
+		p := param.asIntFromType(field.Type())
 
 		return field.Int() == p
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p := asUint(param)
+		p := param.asUint()
 
 		return field.Uint() == p
 
 	case reflect.Float32:
-		p := asFloat32(param)
+		p := param.asFloat32()
 
 		return field.Float() == p
 
 	case reflect.Float64:
-		p := asFloat64(param)
+		p := param.asFloat64()
 
 		return field.Float() == p
 
 	case reflect.Bool:
-		p := asBool(param)
+		p := param.asBool()
 
 		return field.Bool() == p
 	}
@@ -1492,7 +1470,7 @@ func isPostcodeByIso3166Alpha2(fl FieldLevel) bool {
 // example: `postcode_iso3166_alpha2_field=CountryCode`
 func isPostcodeByIso3166Alpha2Field(fl FieldLevel) bool {
 	field := fl.Field()
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 
 	if len(params) != 1 {
 		return false
@@ -2118,7 +2096,7 @@ func requireCheckFieldValue(
 // requiredIf is the validation function
 // The field under validation must be present and not empty only if all the other specified fields are equal to the value following with the specified field.
 func requiredIf(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	if len(params)%2 != 0 {
 		panic(fmt.Sprintf("Bad param number for required_if %s", fl.FieldName()))
 	}
@@ -2142,7 +2120,7 @@ func requiredIf(fl FieldLevel) bool {
 // excludedIf is the validation function
 // The field under validation must not be present or is empty only if all the other specified fields are equal to the value following with the specified field.
 func excludedIf(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	if len(params)%2 != 0 {
 		panic(fmt.Sprintf("Bad param number for excluded_if %s", fl.FieldName()))
 	}
@@ -2158,7 +2136,7 @@ func excludedIf(fl FieldLevel) bool {
 // requiredUnless is the validation function
 // The field under validation must be present and not empty only unless all the other specified fields are equal to the value following with the specified field.
 func requiredUnless(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	if len(params)%2 != 0 {
 		panic(fmt.Sprintf("Bad param number for required_unless %s", fl.FieldName()))
 	}
@@ -2174,7 +2152,7 @@ func requiredUnless(fl FieldLevel) bool {
 // skipUnless is the validation function
 // The field under validation must be present and not empty only unless all the other specified fields are equal to the value following with the specified field.
 func skipUnless(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	if len(params)%2 != 0 {
 		panic(fmt.Sprintf("Bad param number for skip_unless %s", fl.FieldName()))
 	}
@@ -2189,7 +2167,7 @@ func skipUnless(fl FieldLevel) bool {
 // excludedUnless is the validation function
 // The field under validation must not be present or is empty unless all the other specified fields are equal to the value following with the specified field.
 func excludedUnless(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	if len(params)%2 != 0 {
 		panic(fmt.Sprintf("Bad param number for excluded_unless %s", fl.FieldName()))
 	}
@@ -2205,7 +2183,7 @@ func excludedUnless(fl FieldLevel) bool {
 // excludedWith is the validation function
 // The field under validation must not be present or is empty if any of the other specified fields are present.
 func excludedWith(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	for _, param := range params {
 		if !requireCheckFieldKind(fl, param, true) {
 			return !hasValue(fl)
@@ -2217,7 +2195,7 @@ func excludedWith(fl FieldLevel) bool {
 // requiredWith is the validation function
 // The field under validation must be present and not empty only if any of the other specified fields are present.
 func requiredWith(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	for _, param := range params {
 		if !requireCheckFieldKind(fl, param, true) {
 			return hasValue(fl)
@@ -2229,7 +2207,7 @@ func requiredWith(fl FieldLevel) bool {
 // excludedWithAll is the validation function
 // The field under validation must not be present or is empty if all of the other specified fields are present.
 func excludedWithAll(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	for _, param := range params {
 		if requireCheckFieldKind(fl, param, true) {
 			return true
@@ -2241,7 +2219,7 @@ func excludedWithAll(fl FieldLevel) bool {
 // requiredWithAll is the validation function
 // The field under validation must be present and not empty only if all of the other specified fields are present.
 func requiredWithAll(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	for _, param := range params {
 		if requireCheckFieldKind(fl, param, true) {
 			return true
@@ -2262,7 +2240,7 @@ func excludedWithout(fl FieldLevel) bool {
 // requiredWithout is the validation function
 // The field under validation must be present and not empty only when any of the other specified fields are not present.
 func requiredWithout(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	for _, param := range params {
 		if requireCheckFieldKind(fl, param, true) {
 			return hasValue(fl)
@@ -2274,7 +2252,7 @@ func requiredWithout(fl FieldLevel) bool {
 // excludedWithoutAll is the validation function
 // The field under validation must not be present or is empty when all of the other specified fields are not present.
 func excludedWithoutAll(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	for _, param := range params {
 		if !requireCheckFieldKind(fl, param, true) {
 			return true
@@ -2286,7 +2264,7 @@ func excludedWithoutAll(fl FieldLevel) bool {
 // requiredWithoutAll is the validation function
 // The field under validation must be present and not empty only when all of the other specified fields are not present.
 func requiredWithoutAll(fl FieldLevel) bool {
-	params := parseOneOfParam2(fl.Param())
+	params := ParsedParam(fl).Values()
 	for _, param := range params {
 		if !requireCheckFieldKind(fl, param, true) {
 			return true
@@ -2386,36 +2364,36 @@ func isGtField(fl FieldLevel) bool {
 // isGte is the validation function for validating if the current field's value is greater than or equal to the param's value.
 func isGte(fl FieldLevel) bool {
 	field := fl.Field()
-	param := fl.Param()
+	param := ParsedParam(fl)
 
 	switch field.Kind() {
 	case reflect.String:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(utf8.RuneCountInString(field.String())) >= p
 
 	case reflect.Slice, reflect.Map, reflect.Array:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(field.Len()) >= p
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p := asIntFromType(field.Type(), param)
+		p := param.asIntFromType(field.Type())
 
 		return field.Int() >= p
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p := asUint(param)
+		p := param.asUint()
 
 		return field.Uint() >= p
 
 	case reflect.Float32:
-		p := asFloat32(param)
+		p := param.asFloat32()
 
 		return field.Float() >= p
 
 	case reflect.Float64:
-		p := asFloat64(param)
+		p := param.asFloat64()
 
 		return field.Float() >= p
 
@@ -2435,36 +2413,36 @@ func isGte(fl FieldLevel) bool {
 // isGt is the validation function for validating if the current field's value is greater than the param's value.
 func isGt(fl FieldLevel) bool {
 	field := fl.Field()
-	param := fl.Param()
+	param := ParsedParam(fl)
 
 	switch field.Kind() {
 	case reflect.String:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(utf8.RuneCountInString(field.String())) > p
 
 	case reflect.Slice, reflect.Map, reflect.Array:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(field.Len()) > p
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p := asIntFromType(field.Type(), param)
+		p := param.asIntFromType(field.Type())
 
 		return field.Int() > p
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p := asUint(param)
+		p := param.asUint()
 
 		return field.Uint() > p
 
 	case reflect.Float32:
-		p := asFloat32(param)
+		p := param.asFloat32()
 
 		return field.Float() > p
 
 	case reflect.Float64:
-		p := asFloat64(param)
+		p := param.asFloat64()
 
 		return field.Float() > p
 
@@ -2481,36 +2459,36 @@ func isGt(fl FieldLevel) bool {
 // hasLengthOf is the validation function for validating if the current field's value is equal to the param's value.
 func hasLengthOf(fl FieldLevel) bool {
 	field := fl.Field()
-	param := fl.Param()
+	param := ParsedParam(fl)
 
 	switch field.Kind() {
 	case reflect.String:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(utf8.RuneCountInString(field.String())) == p
 
 	case reflect.Slice, reflect.Map, reflect.Array:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(field.Len()) == p
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p := asIntFromType(field.Type(), param)
+		p := param.asIntFromType(field.Type())
 
 		return field.Int() == p
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p := asUint(param)
+		p := param.asUint()
 
 		return field.Uint() == p
 
 	case reflect.Float32:
-		p := asFloat32(param)
+		p := param.asFloat32()
 
 		return field.Float() == p
 
 	case reflect.Float64:
-		p := asFloat64(param)
+		p := param.asFloat64()
 
 		return field.Float() == p
 	}
@@ -2614,36 +2592,36 @@ func isLtField(fl FieldLevel) bool {
 // isLte is the validation function for validating if the current field's value is less than or equal to the param's value.
 func isLte(fl FieldLevel) bool {
 	field := fl.Field()
-	param := fl.Param()
+	param := ParsedParam(fl)
 
 	switch field.Kind() {
 	case reflect.String:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(utf8.RuneCountInString(field.String())) <= p
 
 	case reflect.Slice, reflect.Map, reflect.Array:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(field.Len()) <= p
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p := asIntFromType(field.Type(), param)
+		p := param.asIntFromType(field.Type())
 
 		return field.Int() <= p
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p := asUint(param)
+		p := param.asUint()
 
 		return field.Uint() <= p
 
 	case reflect.Float32:
-		p := asFloat32(param)
+		p := param.asFloat32()
 
 		return field.Float() <= p
 
 	case reflect.Float64:
-		p := asFloat64(param)
+		p := param.asFloat64()
 
 		return field.Float() <= p
 
@@ -2663,36 +2641,36 @@ func isLte(fl FieldLevel) bool {
 // isLt is the validation function for validating if the current field's value is less than the param's value.
 func isLt(fl FieldLevel) bool {
 	field := fl.Field()
-	param := fl.Param()
+	param := ParsedParam(fl)
 
 	switch field.Kind() {
 	case reflect.String:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(utf8.RuneCountInString(field.String())) < p
 
 	case reflect.Slice, reflect.Map, reflect.Array:
-		p := asInt(param)
+		p := param.asInt()
 
 		return int64(field.Len()) < p
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p := asIntFromType(field.Type(), param)
+		p := param.asIntFromType(field.Type())
 
 		return field.Int() < p
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p := asUint(param)
+		p := param.asUint()
 
 		return field.Uint() < p
 
 	case reflect.Float32:
-		p := asFloat32(param)
+		p := param.asFloat32()
 
 		return field.Float() < p
 
 	case reflect.Float64:
-		p := asFloat64(param)
+		p := param.asFloat64()
 
 		return field.Float() < p
 
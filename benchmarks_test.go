@@ -2,8 +2,11 @@ package validator
 
 import (
 	"bytes"
+	"context"
 	sql "database/sql/driver"
 	"errors"
+	"reflect"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -1158,3 +1161,162 @@ func BenchmarkValidateFnParallel(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkStructIntoSimpleSuccess(b *testing.B) {
+	validate := New()
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+
+	validFoo := &Foo{StringValue: "Foobar", IntValue: 7}
+
+	var r Result
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.StructInto(ctx, validFoo, &r)
+	}
+}
+
+func BenchmarkStructIntoSimpleFailure(b *testing.B) {
+	validate := New()
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+
+	invalidFoo := &Foo{StringValue: "Fo", IntValue: 3}
+
+	var r Result
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.StructInto(ctx, invalidFoo, &r)
+	}
+}
+
+func BenchmarkStructIntoSimpleFailureParallel(b *testing.B) {
+	validate := New()
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+
+	invalidFoo := &Foo{StringValue: "Fo", IntValue: 3}
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var r Result
+		for pb.Next() {
+			_ = validate.StructInto(ctx, invalidFoo, &r)
+		}
+	})
+}
+
+func BenchmarkVarStringSuccess(b *testing.B) {
+	validate := New()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.VarString("1", "len=1")
+	}
+}
+
+func BenchmarkVarStringSuccessParallel(b *testing.B) {
+	validate := New()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = validate.VarString("1", "len=1")
+		}
+	})
+}
+
+func BenchmarkVarIntSuccess(b *testing.B) {
+	validate := New()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.VarInt(7, "min=5,max=10")
+	}
+}
+
+func BenchmarkVarFloatSuccess(b *testing.B) {
+	validate := New()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.VarFloat(7.5, "min=5,max=10")
+	}
+}
+
+func BenchmarkStructCacheWarmup(b *testing.B) {
+	values := make([]interface{}, 1000)
+	for i := range values {
+		typ := reflect.StructOf([]reflect.StructField{{
+			Name: "Field" + strconv.Itoa(i),
+			Type: reflect.TypeOf(""),
+			Tag:  `validate:"required"`,
+		}})
+		values[i] = reflect.New(typ).Interface()
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		validate := New()
+		for _, val := range values {
+			_ = validate.Struct(val)
+		}
+	}
+}
+
+func BenchmarkVarTagCacheEvictionParallel(b *testing.B) {
+	validate := New(WithTagCacheSize(64))
+
+	tags := make([]string, 128)
+	for i := range tags {
+		tags[i] = "gte=0,lte=" + strconv.Itoa(i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+		for pb.Next() {
+			_ = validate.VarInt(1, tags[i%len(tags)])
+			i++
+		}
+	})
+}
+
+type nopObserver struct{}
+
+func (nopObserver) ObserveStruct(context.Context, StructEvent)           {}
+func (nopObserver) ObserveValidation(context.Context, ValidationEvent)   {}
+func (nopObserver) ObserveStructLevel(context.Context, StructLevelEvent) {}
+
+func BenchmarkStructSimpleSuccessObserved(b *testing.B) {
+	validate := New(WithObserver(nopObserver{}))
+	type Foo struct {
+		StringValue string `validate:"min=5,max=10"`
+		IntValue    int    `validate:"min=5,max=10"`
+	}
+
+	validFoo := &Foo{StringValue: "Foobar", IntValue: 7}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.Struct(validFoo)
+	}
+}
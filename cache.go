@@ -3,6 +3,7 @@ package validator
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -31,43 +32,124 @@ const (
 )
 
 type structCache struct {
-	lock sync.Mutex
-	m    atomic.Value // map[reflect.Type]*cStruct
+	lock      sync.Mutex
+	m         sync.Map // map[reflect.Type]*cStruct
+	sensitive sync.Map // map[reflect.Type]sensitivity, see Validate.sensitivityOf
+	size      atomic.Int64
+	misses    atomic.Uint64
 }
 
 func (sc *structCache) Get(key reflect.Type) (c *cStruct, found bool) {
-	c, found = sc.m.Load().(map[reflect.Type]*cStruct)[key]
-	return
+	if val, ok := sc.m.Load(key); ok {
+		return val.(*cStruct), true
+	}
+	return nil, false
 }
 
+// Set must be called holding lock.
 func (sc *structCache) Set(key reflect.Type, value *cStruct) {
-	m := sc.m.Load().(map[reflect.Type]*cStruct)
-	nm := make(map[reflect.Type]*cStruct, len(m)+1)
-	for k, v := range m {
-		nm[k] = v
+	if _, loaded := sc.m.Swap(key, value); !loaded {
+		sc.size.Add(1)
+	}
+}
+
+// clear removes every struct, those being validated keep their parsed
+// tags until done.
+func (sc *structCache) clear() {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	sc.sensitive.Clear()
+
+	if sc.size.Load() == 0 {
+		return
 	}
-	nm[key] = value
-	sc.m.Store(nm)
+
+	sc.m.Clear()
+	sc.size.Store(0)
 }
 
+// tagCache caches parsed tags, evicting the least recently used approximately
+// using the CLOCK algorithm once it holds max tags, so lookups only write when
+// marking a tag as used.
 type tagCache struct {
-	lock sync.Mutex
-	m    atomic.Value // map[string]*cTag
+	lock      sync.Mutex
+	m         sync.Map // map[string]*tagEntry
+	max       int      // 0 when unbounded
+	keys      []string // clock of cached tags, only populated when bounded
+	hand      int
+	size      atomic.Int64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+type tagEntry struct {
+	ct   *cTag
+	used atomic.Bool // set when used, cleared as the clock hand passes
+}
+
+// clear removes every tag.
+func (tc *tagCache) clear() {
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	if tc.size.Load() == 0 {
+		return
+	}
+
+	tc.m.Clear()
+	tc.keys = tc.keys[:0]
+	tc.hand = 0
+	tc.size.Store(0)
 }
 
 func (tc *tagCache) Get(key string) (c *cTag, found bool) {
-	c, found = tc.m.Load().(map[string]*cTag)[key]
-	return
+	val, ok := tc.m.Load(key)
+	if !ok {
+		return nil, false
+	}
+
+	e := val.(*tagEntry)
+	if tc.max > 0 && !e.used.Load() {
+		e.used.Store(true)
+	}
+	return e.ct, true
 }
 
+// Set must be called holding lock.
 func (tc *tagCache) Set(key string, value *cTag) {
-	m := tc.m.Load().(map[string]*cTag)
-	nm := make(map[string]*cTag, len(m)+1)
-	for k, v := range m {
-		nm[k] = v
+	if tc.max > 0 {
+		if len(tc.keys) < tc.max {
+			tc.keys = append(tc.keys, key)
+		} else {
+			tc.evict()
+			tc.keys[tc.hand] = key
+			tc.hand = (tc.hand + 1) % len(tc.keys)
+		}
+	}
+
+	if _, loaded := tc.m.Swap(key, &tagEntry{ct: value}); !loaded {
+		tc.size.Add(1)
+	}
+}
+
+// evict removes the first tag not used since the clock hand last passed it,
+// leaving the hand on its slot. Must be called holding lock.
+func (tc *tagCache) evict() {
+	// bounded as tags may be marked used while sweeping
+	for i := 0; i < 2*len(tc.keys); i++ {
+		val, _ := tc.m.Load(tc.keys[tc.hand])
+		if e := val.(*tagEntry); e.used.Load() {
+			e.used.Store(false)
+			tc.hand = (tc.hand + 1) % len(tc.keys)
+			continue
+		}
+		break
 	}
-	nm[key] = value
-	tc.m.Store(nm)
+
+	tc.m.Delete(tc.keys[tc.hand])
+	tc.size.Add(-1)
+	tc.evictions.Add(1)
 }
 
 type cStruct struct {
@@ -81,7 +163,11 @@ type cField struct {
 	name       string
 	altName    string
 	namesEqual bool
+	anonymous  bool
 	cTags      *cTag
+	mods       *cTag             // only populated when the modifier phase is enabled
+	defaultVal reflect.Value     // only populated when defaults are enabled and the field has one
+	labels     map[string]string // map[<locale>]<label>, nil unless the field has labels
 }
 
 type cTag struct {
@@ -89,15 +175,20 @@ type cTag struct {
 	aliasTag             string
 	actualAliasTag       string
 	param                string
+	parsedParam          *Param
 	keys                 *cTag // only populated when using tag's 'keys' and 'endkeys' for map key validation
 	next                 *cTag
 	fn                   FuncCtx
+	modFn                ModifierFunc
 	typeof               tagType
 	hasTag               bool
 	hasAlias             bool
 	hasParam             bool // true if parameter used eg. eq= where the equal sign has been set
 	isBlockEnd           bool // indicates the current tag represents the last validation in the block
 	runValidationWhenNil bool
+	sensitive            bool         // set on every validation of a field tagged sensitive:"true"
+	checkParam           bool         // parameter checked when validating, the field's type being unknown when cached
+	paramTyp             atomic.Value // type of field the parameter was last found valid for
 }
 
 func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStruct {
@@ -113,6 +204,8 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 		return cs
 	}
 
+	v.structCache.misses.Add(1)
+
 	cs = &cStruct{name: sName, fields: make([]*cField, 0), fn: v.structLevelFuncs[typ]}
 
 	numFields := current.NumField()
@@ -154,24 +247,68 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 
 		if len(tag) > 0 {
 			ctag, _ = v.parseFieldTagsRecursive(tag, fld.Name, "", false)
+			v.checkTags(ctag, fld.Type, fld.Name)
 		} else {
 			// even if field doesn't have validations need cTag for traversing to potential inner/nested
 			// elements of the field.
 			ctag = new(cTag)
 		}
 
-		cs.fields = append(cs.fields, &cField{
+		if isSensitiveField(fld) {
+			markSensitive(ctag)
+		}
+
+		cf := &cField{
 			idx:        i,
 			name:       fld.Name,
 			altName:    customName,
 			cTags:      ctag,
 			namesEqual: fld.Name == customName,
-		})
+			anonymous:  fld.Anonymous,
+			labels:     v.fieldLabels(typ, fld),
+		}
+
+		if v.hasModifiers {
+			if modTag := fld.Tag.Get(v.modTagName); len(modTag) > 0 {
+				cf.mods = v.parseModifierTags(modTag, fld.Name)
+			}
+		}
+
+		if v.hasDefaults {
+			if literal, ok := fld.Tag.Lookup(v.defaultTagName); ok {
+				val, err := parseDefault(literal, fld.Type)
+				if err != nil {
+					panic(fmt.Sprintf(invalidDefault, literal, fld.Name, err))
+				}
+				cf.defaultVal = val
+			}
+		}
+
+		cs.fields = append(cs.fields, cf)
 	}
 	v.structCache.Set(typ, cs)
 	return cs
 }
 
+// isSensitiveField returns whether fld is marked sensitive by the struct tag
+// sensitive:"true".
+func isSensitiveField(fld reflect.StructField) bool {
+	sensitive, _ := strconv.ParseBool(fld.Tag.Get(sensitiveTagKey))
+	return sensitive
+}
+
+// markSensitive marks ct and every validation following it, including those
+// of map keys, as sensitive.
+func markSensitive(ct *cTag) {
+	for ; ct != nil; ct = ct.next {
+		ct.sensitive = true
+
+		if ct.keys != nil {
+			markSensitive(ct.keys)
+		}
+	}
+}
+
 func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias string, hasAlias bool) (firstCtag *cTag, current *cTag) {
 	var t string
 	noAlias := len(alias) == 0
@@ -318,6 +455,7 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 				if len(vals) > 1 {
 					current.param = strings.ReplaceAll(strings.ReplaceAll(vals[1], utf8HexComma, ","), utf8Pipe, "|")
 				}
+				current.parsedParam = newParam(current.param)
 			}
 			current.isBlockEnd = true
 		}
@@ -336,9 +474,48 @@ func (v *Validate) fetchCacheTag(tag string) *cTag {
 		// isn't parsed again.
 		ctag, found = v.tagCache.Get(tag)
 		if !found {
+			v.tagCache.misses.Add(1)
+
 			ctag, _ = v.parseFieldTagsRecursive(tag, "", "", false)
+			v.deferParamChecks(ctag)
 			v.tagCache.Set(tag, ctag)
 		}
 	}
 	return ctag
 }
+
+// clearCaches discards parsed structs and tags after a registration changes
+// how they are parsed, so they don't keep using replaced functions. Clearing
+// empty caches, as for the registrations made by New, does nothing.
+func (v *Validate) clearCaches() {
+	v.structCache.clear()
+	v.tagCache.clear()
+}
+
+// CacheStats describes the caches of parsed structs and tags.
+type CacheStats struct {
+	// Structs is the number of struct types cached and StructMisses the number
+	// of times a struct type was parsed.
+	Structs      int
+	StructMisses uint64
+
+	// Tags is the number of tags cached for Var and its variants, TagMisses the
+	// number of times a tag was parsed and TagEvictions the number of tags
+	// evicted to stay within the size set using WithTagCacheSize.
+	Tags         int
+	TagMisses    uint64
+	TagEvictions uint64
+}
+
+// CacheStats returns statistics about the caches of parsed structs and tags.
+// Lookups which find their struct or tag aren't counted to keep them free of
+// contention.
+func (v *Validate) CacheStats() CacheStats {
+	return CacheStats{
+		Structs:      int(v.structCache.size.Load()),
+		StructMisses: v.structCache.misses.Load(),
+		Tags:         int(v.tagCache.size.Load()),
+		TagMisses:    v.tagCache.misses.Load(),
+		TagEvictions: v.tagCache.evictions.Load(),
+	}
+}
@@ -0,0 +1,161 @@
+package validator
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultDefaultTagName = "default"
+	invalidDefault        = "Invalid default value '%s' on field '%s': %s"
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// SetDefaultTagName allows for changing of the default value tag name of 'default'
+func (v *Validate) SetDefaultTagName(name string) {
+	v.defaultTagName = name
+	v.clearCaches()
+}
+
+// parseDefault parses the literal of a default tag into a value assignable to typ,
+// for pointer types the value of the element type is returned.
+func parseDefault(literal string, typ reflect.Type) (reflect.Value, error) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	val := reflect.New(typ).Elem()
+
+	if reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		err := val.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(literal))
+		return val, err
+	}
+
+	switch typ {
+	case timeDurationType:
+		d, err := time.ParseDuration(literal)
+		if err != nil {
+			return val, err
+		}
+		val.SetInt(int64(d))
+		return val, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		val.SetString(literal)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(literal)
+		if err != nil {
+			return val, err
+		}
+		val.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(literal, 0, typ.Bits())
+		if err != nil {
+			return val, err
+		}
+		val.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(literal, 0, typ.Bits())
+		if err != nil {
+			return val, err
+		}
+		val.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(literal, typ.Bits())
+		if err != nil {
+			return val, err
+		}
+		val.SetFloat(f)
+
+	case reflect.Slice:
+		if len(literal) == 0 {
+			return reflect.MakeSlice(typ, 0, 0), nil
+		}
+
+		parts := strings.Split(literal, tagSeparator)
+		val = reflect.MakeSlice(typ, len(parts), len(parts))
+
+		for i, part := range parts {
+			elem, err := parseDefault(strings.TrimSpace(part), typ.Elem())
+			if err != nil {
+				return val, err
+			}
+			setElem(val.Index(i), elem)
+		}
+
+	default:
+		return val, fmt.Errorf("unsupported type %s", typ)
+	}
+	return val, nil
+}
+
+// setElem assigns elem, as returned by parseDefault, to field allocating any pointers.
+func setElem(field reflect.Value, elem reflect.Value) {
+	if field.Kind() == reflect.Ptr {
+		if elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+
+		ptr := reflect.New(field.Type().Elem())
+		setElem(ptr.Elem(), elem)
+		field.Set(ptr)
+		return
+	}
+
+	if field.Kind() == reflect.Slice {
+		// copy so that each struct receives its own backing array
+		s := reflect.MakeSlice(field.Type(), elem.Len(), elem.Len())
+		for i := 0; i < elem.Len(); i++ {
+			setElem(s.Index(i), elem.Index(i))
+		}
+		field.Set(s)
+		return
+	}
+	field.Set(elem)
+}
+
+// applyDefault sets the field to its default value when it is empty.
+//
+// What is considered empty follows the field's leading omit tag so that a
+// default is only applied where that tag would have skipped validation;
+// omitnil only treats nil as empty, omitzero also treats empty slices as empty
+// and otherwise, as with omitempty, nil or the type's zero value is.
+func (v *validate) applyDefault(field reflect.Value, cf *cField) {
+	if !field.CanSet() {
+		return
+	}
+
+	switch field.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		if field.IsNil() {
+			break
+		}
+
+		if cf.cTags != nil && cf.cTags.typeof == typeOmitZero && field.Kind() == reflect.Slice && field.Len() == 0 {
+			break
+		}
+		return
+
+	default:
+		if cf.cTags != nil && cf.cTags.typeof == typeOmitNil {
+			return
+		}
+
+		if !field.IsZero() {
+			return
+		}
+	}
+
+	setElem(field, cf.defaultVal)
+}
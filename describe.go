@@ -0,0 +1,277 @@
+package validator
+
+import (
+	"reflect"
+)
+
+// RuleKind is the kind of a Rule.
+type RuleKind uint8
+
+// Rule kinds
+const (
+	RuleValidation RuleKind = iota
+	RuleOr
+	RuleDive
+	RuleOmitEmpty
+	RuleOmitNil
+	RuleOmitZero
+	RuleIsDefault
+	RuleStructOnly
+	RuleNoStructLevel
+)
+
+var ruleKindNames = [...]string{
+	RuleValidation:    "validation",
+	RuleOr:            "or",
+	RuleDive:          diveTag,
+	RuleOmitEmpty:     omitempty,
+	RuleOmitNil:       omitnil,
+	RuleOmitZero:      omitzero,
+	RuleIsDefault:     isdefault,
+	RuleStructOnly:    structOnlyTag,
+	RuleNoStructLevel: noStructLevelTag,
+}
+
+func (k RuleKind) String() string {
+	if int(k) < len(ruleKindNames) {
+		return ruleKindNames[k]
+	}
+	return "unknown"
+}
+
+// Rule is a single step of a field's compiled validation, in the order it is run.
+type Rule struct {
+	Kind RuleKind
+
+	// Tag is the validation tag eg. 'min', set for RuleValidation and RuleIsDefault.
+	Tag string
+
+	// Param is the tag's parameter eg. '10' for 'min=10'.
+	Param    string
+	HasParam bool
+
+	// Alias is the alias the rule was expanded from, if any, eg. 'iscolor'.
+	Alias string
+
+	// Pattern is the regular expression, in RE2 syntax, a string must match for
+	// baked in validations entirely described by one eg. 'alphanum'.
+	Pattern string
+
+	// Alternatives are the rules of which one must pass, set for RuleOr.
+	Alternatives []Rule
+
+	// Keys are the rules run against map keys, Elem the rules run against each
+	// element or map value and Struct the description of the element type when it
+	// is a struct that will be traversed, set for RuleDive.
+	Keys   []Rule
+	Elem   []Rule
+	Struct *TypeRules
+}
+
+// FieldRules describes the validation of a single struct field.
+type FieldRules struct {
+	// Name is the struct field name and AltName the name returned by the function
+	// registered with RegisterTagNameFunc, or Name when there is none.
+	Name    string
+	AltName string
+
+	Index     int
+	Type      reflect.Type
+	Anonymous bool
+
+	Rules []Rule
+
+	// Struct is the description of the field's type when it is a struct, or a
+	// pointer to one, that will be traversed.
+	Struct *TypeRules
+}
+
+// TypeRules describes the validation of a struct type.
+type TypeRules struct {
+	Type   reflect.Type
+	Name   string
+	Fields []FieldRules
+
+	// StructLevel is true when a struct level validation has been registered for
+	// the type, StructLevelFunc being the function registered with
+	// RegisterStructValidation or RegisterStructValidationCtx.
+	StructLevel     bool
+	StructLevelFunc StructLevelFuncCtx
+}
+
+// Describe returns a read-only description of how values of typ are validated,
+// as compiled from the struct's tags, registered rules, aliases and struct
+// level validations, which can be used to generate documentation or schemas.
+//
+// Pointers are dereferenced and a TypeRules with no fields is returned for types
+// which are not structs, and an empty TypeRules for a nil type. Nested and
+// recursive types share the same *TypeRules.
+func (v *Validate) Describe(typ reflect.Type) TypeRules {
+	if typ == nil {
+		return TypeRules{}
+	}
+	return *v.describeStruct(typ, make(map[reflect.Type]*TypeRules))
+}
+
+func (v *Validate) describeStruct(typ reflect.Type, seen map[reflect.Type]*TypeRules) *TypeRules {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if tr, ok := seen[typ]; ok {
+		return tr
+	}
+
+	tr := &TypeRules{Type: typ, Name: typ.Name()}
+	seen[typ] = tr
+
+	if typ.Kind() != reflect.Struct {
+		return tr
+	}
+
+	cs, ok := v.structCache.Get(typ)
+	if !ok {
+		cs = v.extractStructCache(reflect.New(typ).Elem(), typ.Name())
+	}
+
+	tr.StructLevel = cs.fn != nil
+	tr.StructLevelFunc = cs.fn
+	tr.Fields = make([]FieldRules, 0, len(cs.fields))
+
+	for _, f := range cs.fields {
+		fld := typ.Field(f.idx)
+
+		fr := FieldRules{
+			Name:      f.name,
+			AltName:   f.altName,
+			Index:     f.idx,
+			Type:      fld.Type,
+			Anonymous: f.anonymous,
+			Rules:     v.describeTags(f.cTags, fld.Type, seen),
+		}
+		fr.Struct = v.describeNested(fld.Type, fr.Rules, seen)
+
+		tr.Fields = append(tr.Fields, fr)
+	}
+	return tr
+}
+
+// describeNested returns the description of typ when the validator will traverse into it.
+func (v *Validate) describeNested(typ reflect.Type, rules []Rule, seen map[reflect.Type]*TypeRules) *TypeRules {
+	if typ == nil {
+		return nil
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ.ConvertibleTo(timeType) {
+		return nil
+	}
+
+	for _, r := range rules {
+		if r.Kind == RuleStructOnly {
+			return nil
+		}
+	}
+	return v.describeStruct(typ, seen)
+}
+
+// describeTags converts the cTag chain into rules, typ being the type the chain
+// is run against and nil when it is unknown eg. elements of an interface.
+func (v *Validate) describeTags(ct *cTag, typ reflect.Type, seen map[reflect.Type]*TypeRules) []Rule {
+	var rules []Rule
+
+	for ; ct != nil; ct = ct.next {
+		switch ct.typeof {
+		case typeOmitEmpty:
+			rules = append(rules, Rule{Kind: RuleOmitEmpty})
+
+		case typeOmitNil:
+			rules = append(rules, Rule{Kind: RuleOmitNil})
+
+		case typeOmitZero:
+			rules = append(rules, Rule{Kind: RuleOmitZero})
+
+		case typeStructOnly:
+			rules = append(rules, Rule{Kind: RuleStructOnly})
+
+		case typeNoStructLevel:
+			rules = append(rules, Rule{Kind: RuleNoStructLevel})
+
+		case typeEndKeys:
+
+		case typeDive:
+			var keyTyp, elemTyp reflect.Type
+
+			if typ != nil {
+				for typ.Kind() == reflect.Ptr {
+					typ = typ.Elem()
+				}
+
+				switch typ.Kind() {
+				case reflect.Map:
+					keyTyp = typ.Key()
+					elemTyp = typ.Elem()
+				case reflect.Slice, reflect.Array:
+					elemTyp = typ.Elem()
+				}
+			}
+
+			r := Rule{Kind: RuleDive}
+
+			if ct.next != nil && ct.next.typeof == typeKeys {
+				ct = ct.next
+				r.Keys = v.describeTags(ct.keys, keyTyp, seen)
+			}
+
+			r.Elem = v.describeTags(ct.next, elemTyp, seen)
+			r.Struct = v.describeNested(elemTyp, r.Elem, seen)
+
+			// everything after the dive applies to the elements
+			return append(rules, r)
+
+		case typeOr:
+			r := Rule{Kind: RuleOr}
+
+			for {
+				r.Alternatives = append(r.Alternatives, v.describeTag(ct))
+				if ct.isBlockEnd || ct.next == nil {
+					break
+				}
+				ct = ct.next
+			}
+			rules = append(rules, r)
+
+		default:
+			if !ct.hasTag {
+				continue
+			}
+			rules = append(rules, v.describeTag(ct))
+		}
+	}
+	return rules
+}
+
+func (v *Validate) describeTag(ct *cTag) Rule {
+	r := Rule{
+		Kind:     RuleValidation,
+		Tag:      ct.tag,
+		Param:    ct.param,
+		HasParam: ct.hasParam,
+	}
+
+	if ct.typeof == typeIsDefault {
+		r.Kind = RuleIsDefault
+	}
+
+	if ct.hasAlias {
+		r.Alias = ct.aliasTag
+	}
+
+	if wrapper, ok := v.validations[ct.tag]; ok && wrapper.info.BakedIn {
+		r.Pattern = bakedInRegexStrings[ct.tag]
+	}
+	return r
+}
@@ -15,6 +15,25 @@ in essence only parsing your validation tags once per struct type.
 Using multiple instances neglects the benefit of caching.
 The not thread-safe functions are explicitly marked as such in the documentation.
 
+Registrations discard the cached structs and tags, so re-registering a
+validation after use takes effect. To add registrations while an instance is in
+use, eg. per tenant or plugin, derive an independent instance using Clone:
+
+	tenant := validate.Clone()
+	tenant.RegisterValidation("sku", isTenantSKU)
+
+# Error Order
+
+Errors are returned in the order fields are validated, which is the order they
+are declared in, struct level errors following the fields of their struct.
+Map values are validated in Go's map order, which varies between runs, so sort
+the errors for the same value to always return them in the same order.
+ValidationErrors can be sorted by namespace, by field with struct level errors
+placed with the field they were reported for, or by tag:
+
+	errs := err.(validator.ValidationErrors)
+	errs.Sort(validator.SortByNamespace)
+
 # Validation Functions Return Type error
 
 Doing things this way is actually the way the standard library does, see the
@@ -34,6 +53,13 @@ if the error returned is not nil, and if it's not check if error is
 InvalidValidationError ( if necessary, most of the time it isn't ) type cast
 it to type ValidationErrors like so err.(validator.ValidationErrors).
 
+FieldErrors, and so ValidationErrors, marshal to JSON as the namespace, field,
+tag and param of each error, never the value validated:
+
+	[{"namespace":"User.Name","field":"Name","tag":"min","param":"3"}]
+
+NOTE: before they marshaled as empty objects.
+
 # Custom Validation Functions
 
 Custom Validation functions can be added. Example:
@@ -52,6 +78,17 @@ Custom Validation functions can be added. Example:
 	// NOTES: using the same tag name as an existing function
 	//        will overwrite the existing one
 
+The parameter is parsed when the tag is first cached, so functions can read it
+as a number, duration or list of values without parsing it on every call:
+
+	func isMultipleOf(fl validator.FieldLevel) bool {
+		n, err := validator.ParsedParam(fl).Int()
+		if err != nil {
+			panic(err.Error())
+		}
+		return fl.Field().Int()%n == 0
+	}
+
 # Valuer Interface
 
 Custom types can implement the Valuer interface to return the value that should
@@ -184,6 +221,216 @@ so the above will become excludesall=0x7C
 		Field `validate:"excludesall=0x7C"` // GOOD! Use the UTF-8 hex representation.
 	}
 
+# Modifiers
+
+When created using the WithModifiers option, fields are normalized in place by
+the modifiers listed in their 'mod' tag before any validation runs. Modifiers
+are applied in the order defined and support 'dive' for slices, arrays and map
+values. A pointer to the struct must be passed as values which are not
+addressable cannot be modified. Example:
+
+	type User struct {
+		Email string   `mod:"trim,lower" validate:"required,email"`
+		Tags  []string `mod:"dive,trim" validate:"dive,required"`
+	}
+
+	validate := validator.New(validator.WithModifiers())
+	err := validate.Struct(&user)
+
+Here is a list of the current built in modifiers:
+
+	trim     - removes leading and trailing white space, or the characters in its param eg. trim=#
+	ltrim    - removes leading white space, or the characters in its param
+	rtrim    - removes trailing white space, or the characters in its param
+	lower    - lower cases the value
+	upper    - upper cases the value
+	collapse - replaces each run of white space with a single space
+
+Custom modifiers can be added using RegisterModifier and the tag name changed
+using SetModifierTagName.
+
+# Defaults
+
+When created using the WithDefaults option, empty fields are populated from
+the literal in their 'default' tag before modifiers and validations run.
+Strings, bools, numbers, time.Duration, encoding.TextUnmarshaler
+implementations and slices of these, with elements separated by a comma, are
+supported. Invalid literals panic when the struct is first cached. Example:
+
+	type Config struct {
+		Port    int           `default:"8080" validate:"gte=1,lte=65535"`
+		Timeout time.Duration `default:"30s"`
+		Debug   *bool         `default:"false" validate:"omitnil"`
+	}
+
+	validate := validator.New(validator.WithDefaults())
+	err := validate.Struct(&config)
+
+Nil pointers and slices and fields with their type's zero value are
+considered empty. When the field's validation tags start with omitnil only nil
+is considered empty, when they start with omitzero empty slices are too.
+
+# JSON Merge Patch
+
+StructPresent validates only the fields whose keys were present in the JSON
+document the struct was decoded from, so absent fields are neither required
+nor validated whilst present ones are validated in full, including within
+nested objects, array items and map values. Register a tag name function
+returning the JSON name so fields can be matched to keys. Example:
+
+	var patch UserPatch
+	_ = json.Unmarshal(body, &patch)
+
+	present, err := validator.PresentFromJSON(body)
+	err = validate.StructPresent(&patch, present)
+
+# Field Masks
+
+StructMask validates only the fields matched by a FieldMask, such as one built
+from a gRPC update_mask. Paths are '.' separated field names which may be
+followed by slice index or map key selectors, '*' matching any name, index or
+key. Compile masks once and reuse them. Example:
+
+	mask, err := validator.CompileFieldMask("name", "items[*].price", "meta.*")
+
+	err = validate.StructMask(&order, mask)
+
+# Introspection
+
+Describe returns a read-only model of the compiled rules for a struct type,
+listing each field's names and its rules in order, with aliases expanded,
+'|' groups as alternatives and the key and element rules of 'dive', so
+documentation and schemas can be generated without re-parsing tags. Example:
+
+	rules := validate.Describe(reflect.TypeOf(User{}))
+
+	for _, f := range rules.Fields {
+		fmt.Println(f.AltName, f.Rules)
+	}
+
+Rules of baked in validations which are entirely described by a regular
+expression carry it as Pattern. The schema/zod package uses Describe to
+generate TypeScript types and Zod schemas mirroring a struct's validations,
+schema/htmlform to derive HTML5 input constraint attributes and schema/sqlcheck
+to derive SQL NOT NULL and CHECK constraints.
+
+# Validation Metadata
+
+Validations lists every registered validation with its description, the kinds
+of field it can be used on and the parameter it accepts. Custom validations
+can provide the same using RegisterValidationWithInfo:
+
+	validate.RegisterValidationWithInfo("even", isEven, validator.ValidationInfo{
+		Description: "Even",
+		Kinds:       []reflect.Kind{reflect.Int},
+		Param:       validator.ParamSchema{Kind: validator.ParamNone},
+	})
+
+Creating the validator using WithStrictTags checks tags against this metadata
+when a struct is first validated, panicking for mistakes such as 'min=abc' or
+'len' on a bool rather than when, or if, the validation runs. Parameters of
+tags passed to Var, and of interface fields, are checked when validating,
+panicking in the same way. Without it the numeric and value parameters of the
+baked in validations, such as that of 'min', are still checked in the same way.
+
+NOTE: this is a breaking change for structs with such a mistake on a field
+whose validation never ran, eg. 'omitempty,min=abc' on a field left empty,
+which now panic when first validated. An invalid parameter always panics,
+whichever of Struct, Var or their variants is validating.
+
+# Hot Loops
+
+StructInto validates into a reusable Result, whose errors are only valid until
+the Result is reused, so validating in a loop need not allocate for errors
+beyond a buffer holding their namespaces, whose strings remain valid:
+
+	var r validator.Result
+
+	for _, u := range users {
+		if err := validate.StructInto(ctx, &u, &r); err != nil {
+			log.Println(r.Errors())
+		}
+	}
+
+VarString, VarInt and VarFloat validate a single value like Var without the
+allocation of boxing it in an interface.
+
+# Caching
+
+Struct types and the tags passed to Var are parsed once and cached. Applications
+building tags dynamically can bound the tag cache using WithTagCacheSize, which
+evicts the least recently used tags, and CacheStats reports the size of the
+caches and how often they missed.
+
+# Explaining Validation
+
+ExplainCtx validates a struct like StructCtx, also returning a trace of every
+field visited and rule run in order: the validations with their parameters and
+results, the alternatives of '|' groups tried, the fields whose remaining rules
+were skipped by omitempty, omitnil or omitzero and the struct level functions
+run. Conditional validations eg. required_if which passed as their condition
+did not hold are marked as skipped. Example:
+
+	trace, err := validate.ExplainCtx(ctx, order)
+	fmt.Print(trace)
+
+	// Order.items[1].depot
+	//   required_if=Kind physical: skipped, condition not met
+
+Tracing is slow and intended for debugging rather than production.
+
+# Observing Validation
+
+WithObserver notifies an Observer after each struct, validation function and
+struct level function runs, with the tag, namespace, duration and result, eg.
+to export per tag latency histograms and failure counts:
+
+	func (m *metrics) ObserveValidation(ctx context.Context, e validator.ValidationEvent) {
+		m.latency.WithLabelValues(e.Tag).Observe(e.Duration.Seconds())
+		if !e.Valid {
+			m.failures.WithLabelValues(e.Tag).Inc()
+		}
+	}
+
+	validate := validator.New(validator.WithObserver(m))
+
+# Translations
+
+Errors are translated using the translations registered for a
+ut.Translator, see the translations packages. Tags without a translation for a
+locale fall back along a chain to the parent locale, eg. pt_BR to pt, and then
+to the fallback translator of WithTranslators, before the untranslated error.
+RegisterTranslationFallback replaces a locale's fallbacks, which are followed
+in the same way. MissingTranslations lists the tags lacking a translation for
+a locale:
+
+	validate := validator.New(validator.WithTranslators(uni)) // en as fallback
+
+	for _, tag := range validate.MissingTranslations(ptBR) {
+		log.Printf("no pt_BR translation for %s", tag)
+	}
+
+Translations refer to fields by their label for the locale, given by a struct
+tag naming the locale or registered using RegisterFieldLabel, falling back to
+the field's name, see FieldLabel:
+
+	type User struct {
+		FirstName string `json:"first_name" validate:"required" label_de:"Vorname"`
+	}
+
+	// Vorname ist ein Pflichtfeld
+
+TranslateCtx translates errors using the translator of the locales resolved
+from a context, by default those set using ContextWithLocales, among the
+translators of WithTranslators. Translations registered using
+RegisterTranslationCtx are passed the context eg. for tenant-specific wording:
+
+	validate := validator.New(validator.WithTranslators(uni))
+
+	ctx = validator.ContextWithLocales(ctx, user.Locale, "en")
+
+	msgs := err.(validator.ValidationErrors).TranslateCtx(ctx)
+
 # Build tags
 
 The library provides a build tag for build size optimizations. If you are not using
@@ -250,6 +497,23 @@ Otherwise, behaves the same as omitempty.
 
 	Usage: omitzero
 
+# Sensitive
+
+The struct tag sensitive:"true", set alongside the validate tag rather than
+within it, marks the field's value as sensitive so errors of its validations
+return RedactedValue from FieldError.Value() in place of the value, which is
+therefore kept out of translations. Validations still see the actual value.
+Values of types registered with RegisterSensitiveType are redacted in the same
+way, which is also how to redact values validated using Var, as are the values
+of any field holding a sensitive value eg. a struct with a sensitive field,
+including through interfaces, whose 'required' or 'excluded_if' error would
+otherwise carry it. Being its own struct tag, it leaves the validation name
+sensitive free for custom validations.
+
+	Usage: sensitive:"true"
+
+	Password string `validate:"required,min=12" sensitive:"true"`
+
 # Dive
 
 This tells the validator to dive into a slice, array or map and validate that
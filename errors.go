@@ -2,8 +2,12 @@ package validator
 
 import (
 	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	ut "github.com/go-playground/universal-translator"
@@ -11,6 +15,11 @@ import (
 
 const (
 	fieldErrMsg = "Key: '%s' Error:Field validation for '%s' failed on the '%s' tag"
+
+	// RedactedValue is returned by FieldError.Value() in place of the value of
+	// a field tagged sensitive:"true" or of a type registered with
+	// RegisterSensitiveType.
+	RedactedValue = "[REDACTED]"
 )
 
 // ValidationErrorsTranslations is the translation return type
@@ -72,6 +81,124 @@ func (ve ValidationErrors) Translate(ut ut.Translator) ValidationErrorsTranslati
 	return trans
 }
 
+// TranslateCtx translates all of the ValidationErrors using the translator
+// resolved from ctx, see Validate.TranslatorCtx, passing ctx to the
+// translation functions registered using RegisterTranslationCtx.
+func (ve ValidationErrors) TranslateCtx(ctx context.Context) ValidationErrorsTranslations {
+	trans := make(ValidationErrorsTranslations)
+
+	for i := 0; i < len(ve); i++ {
+		fe := ve[i].(*fieldError)
+		trans[fe.ns] = TranslateCtx(ctx, fe)
+	}
+
+	return trans
+}
+
+// SortOrder is an order ValidationErrors can be sorted in, see ValidationErrors.Sort.
+type SortOrder uint8
+
+// Sort orders
+const (
+	// SortByNamespace orders errors by namespace, the indexes of slices and
+	// arrays and integer map keys being compared as numbers eg. Items[2] before Items[10].
+	SortByNamespace SortOrder = iota
+
+	// SortByField orders errors by the position of their fields in their structs
+	// as declared, as returned except struct level errors being placed with the
+	// field they were reported for rather than after the struct's fields.
+	SortByField
+
+	// SortByTag orders errors by tag, grouping eg. every 'required' error, and
+	// then by field.
+	SortByTag
+)
+
+// Sort sorts the errors in place in the order by, errors which are equal in
+// that order keeping their current order.
+//
+// Errors are returned in the order their fields are validated, struct level
+// errors following the fields of their struct, except that map values are
+// validated in Go's map order, which varies. Sorting in any of the orders
+// returns the same errors in the same order every time, the values of a map
+// being ordered by key.
+func (ve ValidationErrors) Sort(by SortOrder) {
+	switch by {
+	case SortByNamespace:
+		slices.SortStableFunc(ve, func(a, b FieldError) int {
+			return compareNamespaces(a.Namespace(), b.Namespace())
+		})
+
+	case SortByField:
+		slices.SortStableFunc(ve, compareFields)
+
+	case SortByTag:
+		slices.SortStableFunc(ve, func(a, b FieldError) int {
+			if c := strings.Compare(a.Tag(), b.Tag()); c != 0 {
+				return c
+			}
+			return compareFields(a, b)
+		})
+	}
+}
+
+// compareFields compares errors by the position of their fields, the values of
+// a map sharing positions and so being compared by namespace.
+func compareFields(a, b FieldError) int {
+	if c := cmp.Compare(errorSeq(a), errorSeq(b)); c != 0 {
+		return c
+	}
+	return compareNamespaces(a.Namespace(), b.Namespace())
+}
+
+// errorSeq returns the seq of the field of fe, 0 for FieldErrors not returned by the validator.
+func errorSeq(fe FieldError) uint64 {
+	if e, ok := fe.(*fieldError); ok {
+		return e.seq
+	}
+	return 0
+}
+
+// compareNamespaces compares the namespaces a and b, comparing runs of digits
+// as numbers so indexes sort numerically.
+func compareNamespaces(a, b string) int {
+	for len(a) > 0 && len(b) > 0 {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			i, j := digits(a), digits(b)
+			na, nb := strings.TrimLeft(a[:i], "0"), strings.TrimLeft(b[:j], "0")
+
+			if c := cmp.Compare(len(na), len(nb)); c != 0 {
+				return c
+			}
+			if c := strings.Compare(na, nb); c != 0 {
+				return c
+			}
+
+			a, b = a[i:], b[j:]
+			continue
+		}
+
+		if a[0] != b[0] {
+			return cmp.Compare(a[0], b[0])
+		}
+		a, b = a[1:], b[1:]
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// digits returns the length of the run of digits s starts with.
+func digits(s string) int {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
 // FieldError contains all functions to get error details
 type FieldError interface {
 
@@ -127,7 +254,7 @@ type FieldError interface {
 	StructField() string
 
 	// Value returns the actual field's value in case needed for creating the error
-	// message
+	// message, or RedactedValue when the field is sensitive
 	Value() interface{}
 
 	// Param returns the param value, in string form for comparison; this will also
@@ -174,6 +301,9 @@ type fieldError struct {
 	param          string
 	kind           reflect.Kind
 	typ            reflect.Type
+	seq            uint64            // seq of the field when it was visited, see SortByField
+	labels         map[string]string // labels of the field, see FieldLabel
+	sensitive      bool              // value redacted, see RedactedValue
 }
 
 // Tag returns the validation tag that failed.
@@ -222,8 +352,11 @@ func (fe *fieldError) StructField() string {
 }
 
 // Value returns the actual field's value in case needed for creating the error
-// message
+// message, or RedactedValue when the field is sensitive
 func (fe *fieldError) Value() interface{} {
+	if fe.sensitive {
+		return RedactedValue
+	}
 	return fe.value
 }
 
@@ -248,26 +381,83 @@ func (fe *fieldError) Error() string {
 	return fmt.Sprintf(fieldErrMsg, fe.ns, fe.Field(), fe.tag)
 }
 
+// MarshalJSON marshals the field error as its namespace, field, tag and param,
+// leaving out its value so marshaled errors never carry what was validated.
+//
+// NOTE: field errors marshaled as an empty object before, see the package
+// documentation.
+func (fe *fieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Namespace string `json:"namespace"`
+		Field     string `json:"field"`
+		Tag       string `json:"tag"`
+		Param     string `json:"param,omitempty"`
+	}{
+		Namespace: fe.ns,
+		Field:     fe.Field(),
+		Tag:       fe.tag,
+		Param:     fe.param,
+	})
+}
+
+// FieldLabel returns the label of fe's field for the locale of the provided
+// 'ut.Translator', for use in translations in place of Field.
+//
+// Labels are registered using RegisterFieldLabel or struct tags naming the
+// locale eg. label_de:"Vorname". When the field has no label for the locale
+// the label for its language eg. label_pt for pt_BR is used, else Field(),
+// which is also returned for FieldErrors not returned by a Validate.
+func FieldLabel(fe FieldError, ut ut.Translator) string {
+	e, ok := fe.(*fieldError)
+	if !ok || e.labels == nil || ut == nil {
+		return fe.Field()
+	}
+
+	locale := ut.Locale()
+	if label, ok := e.labels[locale]; ok {
+		return label
+	}
+
+	if i := strings.IndexByte(locale, '_'); i > 0 {
+		if label, ok := e.labels[locale[:i]]; ok {
+			return label
+		}
+	}
+	return fe.Field()
+}
+
 // Translate returns the FieldError's translated error
 // from the provided 'ut.Translator' and registered 'TranslationFunc'
 //
+// The fallbacks of ut, see RegisterTranslationFallback, are tried in order
+// when the tag has no translation for ut.
+//
 // NOTE: if no registered translation can be found, it returns the original
 // untranslated error message.
 func (fe *fieldError) Translate(ut ut.Translator) string {
-	var fn TranslationFunc
+	return fe.translate(context.Background(), ut)
+}
 
-	m, ok := fe.v.transTagFunc[ut]
+// TranslateCtx returns fe's translated error from the translator resolved from
+// ctx, see Validate.TranslatorCtx, and registered translation function, passed
+// ctx.
+//
+// NOTE: if no translator is resolved, no registered translation can be found
+// or fe was not returned by a Validate, it returns the original untranslated
+// error message.
+func TranslateCtx(ctx context.Context, fe FieldError) string {
+	e, ok := fe.(*fieldError)
 	if !ok {
 		return fe.Error()
 	}
+	return e.translate(ctx, e.v.TranslatorCtx(ctx))
+}
 
-	fn, ok = m[fe.tag]
+func (fe *fieldError) translate(ctx context.Context, ut ut.Translator) string {
+	trans, fn, ok := fe.v.translation(ut, fe.tag, fe.actualTag)
 	if !ok {
-		fn, ok = m[fe.actualTag]
-		if !ok {
-			return fe.Error()
-		}
+		return fe.Error()
 	}
 
-	return fn(ut, fe)
+	return fn(ctx, trans, fe)
 }
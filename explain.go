@@ -0,0 +1,253 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// TraceKind is the kind of a TraceStep.
+type TraceKind uint8
+
+// Trace step kinds
+const (
+	// TraceField is a field, element or map key or value being visited.
+	TraceField TraceKind = iota
+
+	// TraceValidation is a validation function having run.
+	TraceValidation
+
+	// TraceOr is an alternative of a '|' group having run.
+	TraceOr
+
+	// TraceSkip is the remaining rules of a field being skipped by omitempty,
+	// omitnil, omitzero or isdefault.
+	TraceSkip
+
+	// TraceStructLevel is a struct level function having run.
+	TraceStructLevel
+)
+
+var traceKindNames = [...]string{
+	TraceField:       "field",
+	TraceValidation:  "validation",
+	TraceOr:          "or",
+	TraceSkip:        "skip",
+	TraceStructLevel: "struct level",
+}
+
+func (k TraceKind) String() string {
+	if int(k) < len(traceKindNames) {
+		return traceKindNames[k]
+	}
+	return "unknown"
+}
+
+// conditionalTags are the validations which only check the field when a
+// condition on other fields holds, mapped to whether they check it when the
+// other fields equal their values, or when they don't.
+var conditionalTags = map[string]bool{
+	requiredIfTag:     true,
+	excludedIfTag:     true,
+	requiredUnlessTag: false,
+	excludedUnlessTag: false,
+	skipUnlessTag:     false,
+}
+
+// TraceStep is a single step of a validation run.
+type TraceStep struct {
+	Kind TraceKind
+
+	// Namespace is the namespace of the field eg. User.Addresses[0].City, or of
+	// the struct for TraceStructLevel.
+	Namespace string
+
+	// Tag is the validation tag eg. 'min', or the tag skipping the field for
+	// TraceSkip, Param its parameter and Alias the alias it was expanded from.
+	Tag   string
+	Param string
+	Alias string
+
+	// Passed is the result returned by the validation function.
+	Passed bool
+
+	// Skipped is true when the validation is conditional eg. required_if and
+	// passed as its condition did not hold, without checking the field.
+	Skipped bool
+
+	// Type is the struct's type and Errors the number of errors reported, set
+	// for TraceStructLevel.
+	Type   reflect.Type
+	Errors int
+}
+
+// Trace is the ordered list of steps taken validating a value.
+type Trace struct {
+	Steps []TraceStep
+}
+
+// String returns the trace with a step per line, the steps run against a field
+// being indented below it.
+func (t Trace) String() string {
+	var b strings.Builder
+
+	for _, s := range t.Steps {
+		switch s.Kind {
+		case TraceField:
+			b.WriteString(s.Namespace)
+
+		case TraceStructLevel:
+			b.WriteString("struct level ")
+			b.WriteString(s.Namespace)
+			b.WriteString(": ")
+			b.WriteString(strconv.Itoa(s.Errors))
+			b.WriteString(" errors")
+
+		case TraceSkip:
+			b.WriteString("  ")
+			b.WriteString(s.Tag)
+			b.WriteString(": skipped remaining rules")
+
+		default:
+			b.WriteString("  ")
+			if s.Kind == TraceOr {
+				b.WriteString("| ")
+			}
+			b.WriteString(s.Tag)
+			if len(s.Param) > 0 {
+				b.WriteByte('=')
+				b.WriteString(s.Param)
+			}
+			if len(s.Alias) > 0 {
+				b.WriteString(" (")
+				b.WriteString(s.Alias)
+				b.WriteByte(')')
+			}
+
+			switch {
+			case s.Skipped:
+				b.WriteString(": skipped, condition not met")
+			case s.Passed:
+				b.WriteString(": passed")
+			default:
+				b.WriteString(": failed")
+			}
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Explain validates a struct like Struct, also returning the trace of the
+// fields visited and rules run.
+func (v *Validate) Explain(s interface{}) (Trace, error) {
+	return v.ExplainCtx(context.Background(), s)
+}
+
+// ExplainCtx validates a struct like StructCtx, also returning the trace of
+// the fields visited, the validations run with their results, the fields whose
+// rules were skipped and the struct level functions run, in order.
+//
+// Tracing is slow and intended for debugging why a validation did or didn't
+// fail, not for use in production.
+func (v *Validate) ExplainCtx(ctx context.Context, s interface{}) (t Trace, err error) {
+	val := reflect.ValueOf(s)
+	top := val
+
+	if val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type().ConvertibleTo(timeType) {
+		return t, &InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	// good to validate
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = false
+	vd.trace = &t
+
+	if (v.hasModifiers || v.hasDefaults) && val.CanAddr() {
+		vd.modifyStruct(ctx, val)
+	}
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	err = vd.takeErrors()
+
+	vd.trace = nil
+	v.pool.Put(vd)
+
+	return
+}
+
+// traceField records visiting the field cf.
+func (v *validate) traceField(ns []byte, cf *cField) {
+	v.trace.Steps = append(v.trace.Steps, TraceStep{
+		Kind:      TraceField,
+		Namespace: namespaceString(ns, cf.altName),
+	})
+}
+
+// traceSkip records the remaining rules of the field cf being skipped by ct.
+func (v *validate) traceSkip(ns []byte, cf *cField, ct *cTag) {
+	var tag string
+
+	switch ct.typeof {
+	case typeOmitEmpty:
+		tag = omitempty
+	case typeOmitNil:
+		tag = omitnil
+	case typeOmitZero:
+		tag = omitzero
+	default:
+		tag = ct.tag
+	}
+
+	v.trace.Steps = append(v.trace.Steps, TraceStep{
+		Kind:      TraceSkip,
+		Namespace: namespaceString(ns, cf.altName),
+		Tag:       tag,
+	})
+}
+
+// traceValidation records the validation function of ct having run on the
+// field cf, the field level state still being set.
+func (v *validate) traceValidation(ct *cTag, ns []byte, cf *cField, ok bool) {
+	s := TraceStep{
+		Kind:      TraceValidation,
+		Namespace: namespaceString(ns, cf.altName),
+		Tag:       ct.tag,
+		Param:     ct.param,
+		Passed:    ok,
+	}
+
+	if ct.typeof == typeOr {
+		s.Kind = TraceOr
+	}
+
+	if ct.hasAlias {
+		s.Alias = ct.aliasTag
+	}
+
+	if checks, ok := conditionalTags[ct.tag]; ok && s.Passed {
+		s.Skipped = v.conditionHolds() != checks
+	}
+
+	v.trace.Steps = append(v.trace.Steps, s)
+}
+
+// conditionHolds returns whether every field named in the parameter of a
+// conditional validation equals the value following it.
+func (v *validate) conditionHolds() bool {
+	params := v.ct.parsedParam.Values()
+
+	for i := 0; i+1 < len(params); i += 2 {
+		if !requireCheckFieldValue(v, params[i], params[i+1], false) {
+			return false
+		}
+	}
+	return true
+}
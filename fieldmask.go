@@ -0,0 +1,231 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const wildcard = "*"
+
+// FieldMask is a compiled set of field paths, such as those found in a gRPC
+// update_mask, used to restrict validation with StructMask.
+//
+// A path is a '.' separated list of field names, in either their struct or alt
+// name form as returned by the function registered with RegisterTagNameFunc,
+// each optionally followed by one or more slice index or map key selectors.
+// '*' matches any field name, index or key eg.
+//
+//	name
+//	items[*].price
+//	meta.*
+//	addresses[0].zip
+//	labels[env]
+//
+// Selectors may be omitted to match every element of a slice, array or map, so
+// "items.price" is the same as "items[*].price".
+type FieldMask struct {
+	paths []string
+	root  *maskNode
+}
+
+type maskNode struct {
+	full     bool // the path ends here, everything beneath it matches
+	fields   map[string]*maskNode
+	anyField *maskNode
+	elems    map[string]*maskNode
+	anyElem  *maskNode
+}
+
+// maskScope is the set of mask nodes matching the current position during traversal.
+type maskScope []*maskNode
+
+var _ scope = maskScope(nil)
+
+// CompileFieldMask parses paths into a FieldMask which can be reused across
+// calls to StructMask.
+func CompileFieldMask(paths ...string) (*FieldMask, error) {
+	fm := &FieldMask{
+		paths: paths,
+		root:  new(maskNode),
+	}
+
+	for _, path := range paths {
+		if err := fm.root.add(path); err != nil {
+			return nil, err
+		}
+	}
+	return fm, nil
+}
+
+// MustCompileFieldMask is like CompileFieldMask but panics if a path cannot be parsed.
+func MustCompileFieldMask(paths ...string) *FieldMask {
+	fm, err := CompileFieldMask(paths...)
+	if err != nil {
+		panic(err.Error())
+	}
+	return fm
+}
+
+// Paths returns the paths the mask was compiled from.
+func (fm *FieldMask) Paths() []string {
+	return fm.paths
+}
+
+func (n *maskNode) add(path string) error {
+	if len(path) == 0 {
+		return fmt.Errorf("validator: empty field mask path")
+	}
+
+	for _, seg := range strings.Split(path, namespaceSeparator) {
+		name := seg
+		var selectors []string
+
+		if idx := strings.Index(seg, leftBracket); idx != -1 {
+			name = seg[:idx]
+			rest := seg[idx:]
+
+			for len(rest) > 0 {
+				end := strings.Index(rest, rightBracket)
+				if rest[0] != '[' || end == -1 {
+					return fmt.Errorf("validator: invalid selector in field mask path '%s'", path)
+				}
+				selectors = append(selectors, rest[1:end])
+				rest = rest[end+1:]
+			}
+		}
+
+		if len(name) == 0 {
+			return fmt.Errorf("validator: empty field name in field mask path '%s'", path)
+		}
+
+		n = n.child(name, false)
+
+		for _, sel := range selectors {
+			n = n.child(sel, true)
+		}
+	}
+
+	n.full = true
+	return nil
+}
+
+func (n *maskNode) child(name string, elem bool) *maskNode {
+	if name == wildcard {
+		if elem {
+			if n.anyElem == nil {
+				n.anyElem = new(maskNode)
+			}
+			return n.anyElem
+		}
+
+		if n.anyField == nil {
+			n.anyField = new(maskNode)
+		}
+		return n.anyField
+	}
+
+	m := &n.fields
+	if elem {
+		m = &n.elems
+	}
+
+	if *m == nil {
+		*m = make(map[string]*maskNode)
+	}
+
+	c, ok := (*m)[name]
+	if !ok {
+		c = new(maskNode)
+		(*m)[name] = c
+	}
+	return c
+}
+
+// next returns the scope made up of the matched nodes, nil when one of them
+// matches everything beneath it.
+func (ms maskScope) next(matched maskScope) (scope, bool) {
+	if len(matched) == 0 {
+		return nil, false
+	}
+
+	for _, n := range matched {
+		if n.full {
+			return nil, true
+		}
+	}
+	return matched, true
+}
+
+func (ms maskScope) field(name, altName string) (scope, bool) {
+	var matched maskScope
+
+	for _, n := range ms {
+		if c, ok := n.fields[altName]; ok {
+			matched = append(matched, c)
+		}
+
+		if name != altName {
+			if c, ok := n.fields[name]; ok {
+				matched = append(matched, c)
+			}
+		}
+
+		if n.anyField != nil {
+			matched = append(matched, n.anyField)
+		}
+	}
+	return ms.next(matched)
+}
+
+func (ms maskScope) index(i int) (scope, bool) {
+	return ms.key(strconv.Itoa(i))
+}
+
+func (ms maskScope) key(k string) (scope, bool) {
+	var matched maskScope
+
+	for _, n := range ms {
+		if n.elems == nil && n.anyElem == nil {
+			// no selector given, so every element matches
+			matched = append(matched, n)
+			continue
+		}
+
+		if c, ok := n.elems[k]; ok {
+			matched = append(matched, c)
+		}
+
+		if n.anyElem != nil {
+			matched = append(matched, n.anyElem)
+		}
+	}
+	return ms.next(matched)
+}
+
+// StructMask validates only the fields of s matched by mask, including the
+// fields leading to them, and automatically validates nested structs, unless
+// otherwise specified.
+//
+// Matching is applied during traversal, so selectors and wildcards apply to
+// elements reached using 'dive' and to map values. A nil mask validates all
+// fields.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructMask(s interface{}, mask *FieldMask) error {
+	return v.StructMaskCtx(context.Background(), s, mask)
+}
+
+// StructMaskCtx does the same as StructMask and allows passing of contextual
+// validation information via context.Context.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructMaskCtx(ctx context.Context, s interface{}, mask *FieldMask) error {
+	if mask == nil || mask.root.full {
+		return v.structScopedCtx(ctx, s, nil)
+	}
+	return v.structScopedCtx(ctx, s, maskScope{mask.root})
+}
@@ -10,6 +10,7 @@ require (
 	github.com/leodido/go-urn v1.4.0
 	golang.org/x/crypto v0.54.0
 	golang.org/x/text v0.40.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require golang.org/x/sys v0.47.0 // indirect
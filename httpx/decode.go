@@ -0,0 +1,213 @@
+package httpx
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeDurationType    = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// decodeValues populates the struct pointed to by dst from vals.
+//
+// Field names are taken from tagKey, then the `json` tag and finally the Go field
+// name; nested structs are addressed using dot notation eg. "address.city" while
+// embedded structs are flattened.
+func decodeValues(dst interface{}, vals url.Values, tagKey string) error {
+	if len(vals) == 0 {
+		return nil
+	}
+
+	val := reflect.ValueOf(dst)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return fmt.Errorf("httpx: cannot decode into %T", dst)
+	}
+
+	val = val.Elem()
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+	return decodeStruct(val, vals, tagKey, "")
+}
+
+func decodeStruct(current reflect.Value, vals url.Values, tagKey, prefix string) error {
+	typ := current.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		fld := typ.Field(i)
+
+		if !fld.IsExported() && !fld.Anonymous {
+			continue
+		}
+
+		name := fieldName(fld, tagKey)
+		if name == "-" {
+			continue
+		}
+
+		field := current.Field(i)
+
+		if fld.Anonymous && fld.Type.Kind() == reflect.Struct && name == fld.Name {
+			if err := decodeStruct(field, vals, tagKey, prefix); err != nil {
+				return err
+			}
+			continue
+		}
+
+		key := prefix + name
+		ft := fld.Type
+
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if ft.Kind() == reflect.Struct && !reflect.PointerTo(ft).Implements(textUnmarshalerType) {
+			if !hasPrefix(vals, key+".") {
+				continue
+			}
+
+			if err := decodeStruct(allocate(field), vals, tagKey, key+"."); err != nil {
+				return err
+			}
+			continue
+		}
+
+		raw, ok := vals[key]
+		if !ok || len(raw) == 0 {
+			continue
+		}
+
+		if err := setValue(field, raw); err != nil {
+			return fmt.Errorf("invalid value for %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func fieldName(fld reflect.StructField, tagKey string) string {
+	for _, key := range []string{tagKey, "json"} {
+		if name := strings.SplitN(fld.Tag.Get(key), ",", 2)[0]; len(name) > 0 {
+			return name
+		}
+	}
+	return fld.Name
+}
+
+func hasPrefix(vals url.Values, prefix string) bool {
+	for k := range vals {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// allocate dereferences field, allocating any nil pointers along the way.
+func allocate(field reflect.Value) reflect.Value {
+	for field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		field = field.Elem()
+	}
+	return field
+}
+
+func setValue(field reflect.Value, raw []string) error {
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+		s := reflect.MakeSlice(field.Type(), len(raw), len(raw))
+		for i := range raw {
+			if err := setScalar(s.Index(i), raw[i]); err != nil {
+				return err
+			}
+		}
+		field.Set(s)
+		return nil
+	}
+	return setScalar(field, raw[len(raw)-1])
+}
+
+func setScalar(field reflect.Value, s string) error {
+	field = allocate(field)
+
+	if field.CanAddr() {
+		if u, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(s))
+		}
+	}
+
+	switch field.Type() {
+	case timeDurationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+
+	case reflect.Bool:
+		if len(s) == 0 {
+			field.SetBool(false)
+			return nil
+		}
+		if s == "on" {
+			field.SetBool(true)
+			return nil
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if len(s) == 0 {
+			return nil
+		}
+		i, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if len(s) == 0 {
+			return nil
+		}
+		u, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		if len(s) == 0 {
+			return nil
+		}
+		f, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+
+	case reflect.Slice:
+		// []byte
+		field.SetBytes([]byte(s))
+
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Package httpx provides net/http glue for binding a request into a struct,
+// validating it with a *validator.Validate and answering with an
+// RFC 9457 problem details document when the input is not acceptable.
+//
+// A typical handler looks like:
+//
+//	type CreateUser struct {
+//	    FirstName string `json:"first_name" form:"first_name" validate:"required"`
+//	    Email     string `json:"email" form:"email" validate:"required,email"`
+//	}
+//
+//	http.Handle("/users", httpx.Bind(validate, func(w http.ResponseWriter, r *http.Request, in *CreateUser) {
+//	    // in has been decoded and validated
+//	}, httpx.WithTranslator(uni)))
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	defaultMaxBodyBytes = 1 << 20
+
+	contentTypeJSON      = "application/json"
+	contentTypeForm      = "application/x-www-form-urlencoded"
+	contentTypeMultipart = "multipart/form-data"
+	contentTypeProblem   = "application/problem+json"
+)
+
+// HandlerFunc is the function invoked by Bind once the request has been decoded
+// into a T and validated successfully.
+type HandlerFunc[T any] func(w http.ResponseWriter, r *http.Request, in *T)
+
+// ErrorHandlerFunc is called when binding fails, either because the request
+// could not be decoded or because validation failed. status is the HTTP status
+// code Bind would have used.
+type ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, status int, problem *Problem)
+
+// Option represents a configuration option applied to Bind.
+type Option func(*config)
+
+type config struct {
+	uni          *ut.UniversalTranslator
+	maxBodyBytes int64
+	errorHandler ErrorHandlerFunc
+}
+
+// WithTranslator enables translation of validation errors. The translator used for
+// each request is negotiated from its Accept-Language header against the locales
+// registered on uni, falling back to uni's fallback translator.
+func WithTranslator(uni *ut.UniversalTranslator) Option {
+	return func(c *config) {
+		c.uni = uni
+	}
+}
+
+// WithMaxBodyBytes limits the size of request bodies that will be decoded.
+//
+// The default is 1MB.
+func WithMaxBodyBytes(n int64) Option {
+	return func(c *config) {
+		c.maxBodyBytes = n
+	}
+}
+
+// WithErrorHandler overrides how problems are written to the client; by default
+// the problem is written as application/problem+json.
+func WithErrorHandler(fn ErrorHandlerFunc) Option {
+	return func(c *config) {
+		c.errorHandler = fn
+	}
+}
+
+// Bind returns an http.Handler that decodes each request into a new T, validates
+// it using v.StructCtx with the request's context and calls fn when it is valid.
+//
+// The request is decoded from:
+//   - the JSON body when the Content-Type is application/json or ends in +json
+//   - the parsed form, including the query string, when the Content-Type is
+//     application/x-www-form-urlencoded or multipart/form-data
+//   - the query string for requests without a body
+//
+// Form and query values are matched to fields using the `form` and `query` tags
+// respectively, falling back to the `json` tag name and then the field name.
+//
+// When decoding fails a 400 or 415 problem is written, or 413 when the body
+// exceeds the maximum size; when validation fails a 422 problem is written
+// listing each field error.
+//
+// T must be a struct type.
+func Bind[T any](v *validator.Validate, fn HandlerFunc[T], opts ...Option) http.Handler {
+	c := &config{
+		maxBodyBytes: defaultMaxBodyBytes,
+		errorHandler: WriteProblem,
+	}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		in := new(T)
+
+		if status, err := c.decode(w, r, in); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				status = http.StatusRequestEntityTooLarge
+			}
+
+			c.errorHandler(w, r, status, &Problem{
+				Type:   "about:blank",
+				Title:  http.StatusText(status),
+				Status: status,
+				Detail: err.Error(),
+			})
+			return
+		}
+
+		err := v.StructCtx(r.Context(), in)
+		if err == nil {
+			fn(w, r, in)
+			return
+		}
+
+		var errs validator.ValidationErrors
+		if !errors.As(err, &errs) {
+			c.errorHandler(w, r, http.StatusInternalServerError, &Problem{
+				Type:   "about:blank",
+				Title:  http.StatusText(http.StatusInternalServerError),
+				Status: http.StatusInternalServerError,
+				Detail: err.Error(),
+			})
+			return
+		}
+
+		var trans ut.Translator
+		if c.uni != nil {
+			trans = Negotiate(c.uni, r.Header.Get("Accept-Language"))
+			w.Header().Set("Content-Language", strings.ReplaceAll(trans.Locale(), "_", "-"))
+		}
+
+		c.errorHandler(w, r, http.StatusUnprocessableEntity, NewProblem(errs, trans))
+	})
+}
+
+func (c *config) decode(w http.ResponseWriter, r *http.Request, dst interface{}) (int, error) {
+	if r.Body == nil || r.Body == http.NoBody || r.Method == http.MethodGet || r.Method == http.MethodHead {
+		if err := decodeValues(dst, r.URL.Query(), "query"); err != nil {
+			return http.StatusBadRequest, err
+		}
+		return 0, nil
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, c.maxBodyBytes)
+
+	ct := r.Header.Get("Content-Type")
+	if len(ct) == 0 {
+		ct = contentTypeJSON
+	}
+
+	mediaType, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return http.StatusUnsupportedMediaType, err
+	}
+
+	switch {
+	case mediaType == contentTypeJSON || strings.HasSuffix(mediaType, "+json"):
+		dec := json.NewDecoder(r.Body)
+		if err := dec.Decode(dst); err != nil {
+			return http.StatusBadRequest, fmt.Errorf("invalid JSON body: %w", err)
+		}
+		return 0, nil
+
+	case mediaType == contentTypeForm:
+		if err := r.ParseForm(); err != nil {
+			return http.StatusBadRequest, err
+		}
+
+	case mediaType == contentTypeMultipart:
+		if err := r.ParseMultipartForm(c.maxBodyBytes); err != nil {
+			return http.StatusBadRequest, err
+		}
+
+	default:
+		return http.StatusUnsupportedMediaType, fmt.Errorf("unsupported Content-Type %q", mediaType)
+	}
+
+	if err := decodeValues(dst, r.Form, "form"); err != nil {
+		return http.StatusBadRequest, err
+	}
+	return 0, nil
+}
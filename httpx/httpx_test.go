@@ -0,0 +1,203 @@
+package httpx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/go-playground/assert/v2"
+	"github.com/go-playground/locales/de"
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+
+	"github.com/go-playground/validator/v10"
+	de_translations "github.com/go-playground/validator/v10/translations/de"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+)
+
+type address struct {
+	City string `json:"city" form:"city" validate:"required"`
+}
+
+type createUser struct {
+	FirstName string        `json:"first_name" form:"first_name" query:"first_name" validate:"required"`
+	Age       int           `json:"age" form:"age" query:"age" validate:"gte=0,lte=130"`
+	Tags      []string      `json:"tags" form:"tag" query:"tag" validate:"dive,required"`
+	Timeout   time.Duration `json:"timeout" form:"timeout" query:"timeout"`
+	Address   *address      `json:"address" form:"address"`
+}
+
+func newTestValidator(t *testing.T) (*validator.Validate, *ut.UniversalTranslator) {
+	t.Helper()
+
+	english := en.New()
+	uni := ut.New(english, english, de.New())
+
+	v := validator.New()
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
+
+	enTrans, _ := uni.GetTranslator("en")
+	Equal(t, en_translations.RegisterDefaultTranslations(v, enTrans), nil)
+
+	deTrans, _ := uni.GetTranslator("de")
+	Equal(t, de_translations.RegisterDefaultTranslations(v, deTrans), nil)
+
+	return v, uni
+}
+
+func TestBindJSON(t *testing.T) {
+	v, uni := newTestValidator(t)
+
+	var got *createUser
+
+	h := Bind(v, func(w http.ResponseWriter, r *http.Request, in *createUser) {
+		got = in
+		w.WriteHeader(http.StatusNoContent)
+	}, WithTranslator(uni))
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"first_name":"Joey","age":30,"tags":["a"],"address":{"city":"Berlin"}}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	Equal(t, rec.Code, http.StatusNoContent)
+	NotEqual(t, got, nil)
+	Equal(t, got.FirstName, "Joey")
+	Equal(t, got.Address.City, "Berlin")
+
+	req = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"age":200,"tags":[""],"address":{}}`))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Accept-Language", "fr-CH, de;q=0.9, en;q=0.8")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	Equal(t, rec.Code, http.StatusUnprocessableEntity)
+	Equal(t, rec.Header().Get("Content-Type"), "application/problem+json")
+	Equal(t, rec.Header().Get("Content-Language"), "de")
+
+	var p Problem
+	Equal(t, json.Unmarshal(rec.Body.Bytes(), &p), nil)
+	Equal(t, p.Status, http.StatusUnprocessableEntity)
+	Equal(t, len(p.Errors), 4)
+	Equal(t, p.Errors[0].Field, "first_name")
+	Equal(t, p.Errors[0].Tag, "required")
+	Equal(t, p.Errors[0].Message, "first_name ist ein Pflichtfeld")
+	Equal(t, p.Errors[1].Field, "age")
+	Equal(t, p.Errors[1].Param, "130")
+	Equal(t, p.Errors[2].Field, "tags[0]")
+	Equal(t, p.Errors[3].Field, "address.city")
+}
+
+func TestBindForm(t *testing.T) {
+	v, uni := newTestValidator(t)
+
+	var got *createUser
+
+	h := Bind(v, func(w http.ResponseWriter, r *http.Request, in *createUser) {
+		got = in
+	}, WithTranslator(uni))
+
+	form := url.Values{
+		"first_name":   {"Joey"},
+		"age":          {"42"},
+		"tag":          {"a", "b"},
+		"timeout":      {"1m30s"},
+		"address.city": {"Paris"},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	Equal(t, rec.Code, http.StatusOK)
+	Equal(t, got.FirstName, "Joey")
+	Equal(t, got.Age, 42)
+	Equal(t, got.Tags, []string{"a", "b"})
+	Equal(t, got.Timeout, 90*time.Second)
+	Equal(t, got.Address.City, "Paris")
+
+	form.Set("age", "old")
+	req = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	Equal(t, rec.Code, http.StatusBadRequest)
+}
+
+func TestBindQuery(t *testing.T) {
+	v, uni := newTestValidator(t)
+
+	h := Bind(v, func(w http.ResponseWriter, r *http.Request, in *createUser) {
+		_, _ = w.Write([]byte(in.FirstName))
+	}, WithTranslator(uni))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users?first_name=Joey&tag=x", nil))
+	Equal(t, rec.Code, http.StatusOK)
+	Equal(t, rec.Body.String(), "Joey")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users", nil))
+	Equal(t, rec.Code, http.StatusUnprocessableEntity)
+	Equal(t, rec.Header().Get("Content-Language"), "en")
+
+	var p Problem
+	Equal(t, json.Unmarshal(rec.Body.Bytes(), &p), nil)
+	Equal(t, p.Errors[0].Message, "first_name is a required field")
+}
+
+func TestBindTooLarge(t *testing.T) {
+	v, _ := newTestValidator(t)
+
+	h := Bind(v, func(w http.ResponseWriter, r *http.Request, in *createUser) {}, WithMaxBodyBytes(16))
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"first_name":"Joey","age":30}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	Equal(t, rec.Code, http.StatusRequestEntityTooLarge)
+
+	req = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("first_name=Joey&age=30"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	Equal(t, rec.Code, http.StatusRequestEntityTooLarge)
+
+	var p Problem
+	Equal(t, json.Unmarshal(rec.Body.Bytes(), &p), nil)
+	Equal(t, p.Status, http.StatusRequestEntityTooLarge)
+}
+
+func TestBindUnsupportedMediaType(t *testing.T) {
+	v, _ := newTestValidator(t)
+
+	h := Bind(v, func(w http.ResponseWriter, r *http.Request, in *createUser) {})
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("<user/>"))
+	req.Header.Set("Content-Type", "application/xml")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	Equal(t, rec.Code, http.StatusUnsupportedMediaType)
+}
+
+func TestNegotiate(t *testing.T) {
+	english := en.New()
+	uni := ut.New(english, english, de.New())
+
+	Equal(t, Negotiate(uni, "").Locale(), "en")
+	Equal(t, Negotiate(uni, "de-AT").Locale(), "de")
+	Equal(t, Negotiate(uni, "en;q=0.5, de;q=0.7").Locale(), "de")
+	Equal(t, Negotiate(uni, "de;q=0, fr").Locale(), "en")
+}
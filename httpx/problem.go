@@ -0,0 +1,144 @@
+package httpx
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Problem is an RFC 9457 problem details document, extended with the list of
+// field errors that caused it.
+type Problem struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []FieldProblem `json:"errors,omitempty"`
+}
+
+// FieldProblem describes a single failed validation.
+type FieldProblem struct {
+	// Field is the namespace of the field relative to the bound struct,
+	// eg. "address.city"
+	Field   string `json:"field"`
+	Tag     string `json:"tag"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// NewProblem converts errs into a 422 Problem; when trans is not nil each
+// message is translated with it, otherwise FieldError.Error() is used.
+func NewProblem(errs validator.ValidationErrors, trans ut.Translator) *Problem {
+	p := &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusUnprocessableEntity),
+		Status: http.StatusUnprocessableEntity,
+		Detail: "one or more fields failed validation",
+		Errors: make([]FieldProblem, 0, len(errs)),
+	}
+
+	for _, fe := range errs {
+		fp := FieldProblem{
+			Field: relativeNamespace(fe.Namespace()),
+			Tag:   fe.Tag(),
+			Param: fe.Param(),
+		}
+
+		if trans != nil {
+			fp.Message = fe.Translate(trans)
+		} else {
+			fp.Message = fe.Error()
+		}
+		p.Errors = append(p.Errors, fp)
+	}
+	return p
+}
+
+// WriteProblem writes p as application/problem+json using the given status.
+func WriteProblem(w http.ResponseWriter, _ *http.Request, status int, p *Problem) {
+	w.Header().Set("Content-Type", contentTypeProblem)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// relativeNamespace strips the top level struct name from a namespace so
+// "User.address.city" becomes "address.city".
+func relativeNamespace(ns string) string {
+	if idx := strings.IndexByte(ns, '.'); idx != -1 {
+		return ns[idx+1:]
+	}
+	return ns
+}
+
+// Negotiate returns the translator that best matches an Accept-Language header
+// value, honouring quality values and falling back from a regional locale to its
+// base language eg. "pt-PT" to "pt". When nothing matches uni's fallback
+// translator is returned.
+func Negotiate(uni *ut.UniversalTranslator, acceptLanguage string) ut.Translator {
+	langs := parseAcceptLanguage(acceptLanguage)
+	if idx := slices.Index(langs, "*"); idx != -1 {
+		langs = langs[:idx]
+	}
+
+	if trans, found := validator.FindTranslator(langs, uni.GetTranslator); found {
+		return trans
+	}
+	return uni.GetFallback()
+}
+
+// parseAcceptLanguage returns the language ranges of an Accept-Language header
+// ordered by descending quality; ranges with q=0 are dropped.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		lang string
+		q    float64
+	}
+
+	var langs []weighted
+
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+
+		q := 1.0
+
+		if idx := strings.IndexByte(part, ';'); idx != -1 {
+			params := part[idx+1:]
+			part = strings.TrimSpace(part[:idx])
+
+			for _, param := range strings.Split(params, ";") {
+				param = strings.TrimSpace(param)
+				if strings.HasPrefix(param, "q=") {
+					if f, err := strconv.ParseFloat(param[2:], 64); err == nil {
+						q = f
+					}
+				}
+			}
+		}
+
+		if q <= 0 {
+			continue
+		}
+		langs = append(langs, weighted{lang: part, q: q})
+	}
+
+	sort.SliceStable(langs, func(i, j int) bool {
+		return langs[i].q > langs[j].q
+	})
+
+	res := make([]string, len(langs))
+	for i := range langs {
+		res[i] = langs[i].lang
+	}
+	return res
+}
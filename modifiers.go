@@ -0,0 +1,308 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	defaultModTagName = "mod"
+	undefinedModifier = "Undefined modifier function '%s' on field '%s'"
+)
+
+// ModifierFunc accepts a context.Context and FieldLevel interface and is used to
+// normalize a field's value in place before validation runs.
+//
+// fl.Field() is always settable when a ModifierFunc is called.
+type ModifierFunc func(ctx context.Context, fl FieldLevel)
+
+var bakedInModifiers = map[string]ModifierFunc{
+	"trim":     trimSpace,
+	"ltrim":    trimLeft,
+	"rtrim":    trimRight,
+	"lower":    toLower,
+	"upper":    toUpper,
+	"collapse": collapseSpace,
+}
+
+// RegisterModifier adds a modifier with the given tag for use in the modifier tag
+// when the transform phase has been enabled using WithModifiers.
+//
+// NOTES:
+// - if the key already exists, the previous modifier function will be replaced.
+// - this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterModifier(tag string, fn ModifierFunc) error {
+	return v.registerModifier(tag, fn, false)
+}
+
+func (v *Validate) registerModifier(tag string, fn ModifierFunc, bakedIn bool) error {
+	if len(tag) == 0 {
+		return errors.New("function Key cannot be empty")
+	}
+
+	if fn == nil {
+		return errors.New("function cannot be empty")
+	}
+
+	_, ok := restrictedTags[tag]
+	if !bakedIn && (ok || strings.ContainsAny(tag, restrictedTagChars)) {
+		panic(fmt.Sprintf(restrictedTagErr, tag))
+	}
+	v.modifiers[tag] = fn
+	v.clearCaches()
+	return nil
+}
+
+// SetModifierTagName allows for changing of the default modifier tag name of 'mod'
+func (v *Validate) SetModifierTagName(name string) {
+	v.modTagName = name
+	v.clearCaches()
+}
+
+// Modify runs only the modifier phase against the struct pointed to by s,
+// applying defaults and normalizing its fields in place without validating them.
+//
+// It returns InvalidValidationError for bad values passed in.
+func (v *Validate) Modify(s interface{}) error {
+	return v.ModifyCtx(context.Background(), s)
+}
+
+// ModifyCtx does the same as Modify and allows passing of contextual information
+// to modifiers via context.Context.
+func (v *Validate) ModifyCtx(ctx context.Context, s interface{}) error {
+	val := reflect.ValueOf(s)
+
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	vd := v.pool.Get().(*validate)
+	vd.top = val
+	vd.modifyStruct(ctx, val.Elem())
+	v.pool.Put(vd)
+
+	return nil
+}
+
+func (v *Validate) parseModifierTags(tag string, fieldName string) (firstCtag *cTag) {
+	var current *cTag
+
+	for _, t := range strings.Split(tag, tagSeparator) {
+		ct := &cTag{tag: t, hasTag: true}
+
+		if t == diveTag {
+			ct.typeof = typeDive
+		} else {
+			vals := strings.SplitN(t, tagKeySeparator, 2)
+			ct.tag = vals[0]
+
+			fn, ok := v.modifiers[ct.tag]
+			if !ok {
+				panic(strings.TrimSpace(fmt.Sprintf(undefinedModifier, ct.tag, fieldName)))
+			}
+			ct.modFn = fn
+
+			if len(vals) > 1 {
+				ct.hasParam = true
+				ct.param = strings.ReplaceAll(vals[1], utf8HexComma, ",")
+			}
+			ct.parsedParam = newParam(ct.param)
+		}
+
+		if current == nil {
+			firstCtag = ct
+		} else {
+			current.next = ct
+		}
+		current = ct
+	}
+	return
+}
+
+// modifyStruct applies the defaults and runs the modifiers of all of current's fields,
+// current must be addressable.
+func (v *validate) modifyStruct(ctx context.Context, current reflect.Value) {
+	typ := current.Type()
+
+	cs, ok := v.v.structCache.Get(typ)
+	if !ok {
+		cs = v.v.extractStructCache(current, typ.Name())
+	}
+
+	var field reflect.Value
+
+	for _, f := range cs.fields {
+		field = current.Field(f.idx)
+
+		if f.defaultVal.IsValid() {
+			v.applyDefault(field, f)
+		}
+
+		v.modifyField(ctx, current, field, f, f.mods)
+	}
+}
+
+// modifyField applies the modifiers in ct to current and then recurses into
+// nested structs, allocation is never performed so nil pointers are left untouched.
+func (v *validate) modifyField(ctx context.Context, parent reflect.Value, current reflect.Value, cf *cField, ct *cTag) {
+	for current.Kind() == reflect.Ptr || current.Kind() == reflect.Interface {
+		if current.IsNil() {
+			return
+		}
+		current = current.Elem()
+	}
+
+	for ; ct != nil; ct = ct.next {
+		if ct.typeof == typeDive {
+			v.modifyDive(ctx, parent, current, cf, ct.next)
+			return
+		}
+
+		if !current.CanSet() {
+			return
+		}
+
+		v.slflParent = parent
+		v.flField = current
+		v.cf = cf
+		v.ct = ct
+
+		ct.modFn(ctx, v)
+	}
+
+	if current.Kind() == reflect.Struct && current.CanAddr() && !current.Type().ConvertibleTo(timeType) {
+		v.modifyStruct(ctx, current)
+	}
+}
+
+func (v *validate) modifyDive(ctx context.Context, parent reflect.Value, current reflect.Value, cf *cField, ct *cTag) {
+	switch current.Kind() {
+	case reflect.Slice, reflect.Array:
+		reusableCF := &cField{}
+
+		for i := 0; i < current.Len(); i++ {
+			reusableCF.name = cf.name + "[" + strconv.Itoa(i) + "]"
+			reusableCF.altName = reusableCF.name
+			v.modifyField(ctx, parent, current.Index(i), reusableCF, ct)
+		}
+
+	case reflect.Map:
+		reusableCF := &cField{}
+
+		// map values are not addressable, so each is copied, modified and stored back
+		iter := current.MapRange()
+		for iter.Next() {
+			val := reflect.New(current.Type().Elem()).Elem()
+			val.Set(iter.Value())
+
+			reusableCF.name = fmt.Sprintf("%s[%v]", cf.name, iter.Key())
+			reusableCF.altName = reusableCF.name
+			v.modifyField(ctx, parent, val, reusableCF, ct)
+
+			current.SetMapIndex(iter.Key(), val)
+		}
+
+	default:
+		panic("dive error! can't dive on a non slice or map")
+	}
+}
+
+// trimSpace removes leading and trailing white space, or the characters in the
+// param when one is provided.
+func trimSpace(ctx context.Context, fl FieldLevel) {
+	field := fl.Field()
+
+	if field.Kind() != reflect.String {
+		return
+	}
+
+	if param := fl.Param(); len(param) > 0 {
+		field.SetString(strings.Trim(field.String(), param))
+		return
+	}
+	field.SetString(strings.TrimSpace(field.String()))
+}
+
+// trimLeft removes leading white space, or the characters in the param when one
+// is provided.
+func trimLeft(ctx context.Context, fl FieldLevel) {
+	field := fl.Field()
+
+	if field.Kind() != reflect.String {
+		return
+	}
+
+	if param := fl.Param(); len(param) > 0 {
+		field.SetString(strings.TrimLeft(field.String(), param))
+		return
+	}
+	field.SetString(strings.TrimLeftFunc(field.String(), unicode.IsSpace))
+}
+
+// trimRight removes trailing white space, or the characters in the param when
+// one is provided.
+func trimRight(ctx context.Context, fl FieldLevel) {
+	field := fl.Field()
+
+	if field.Kind() != reflect.String {
+		return
+	}
+
+	if param := fl.Param(); len(param) > 0 {
+		field.SetString(strings.TrimRight(field.String(), param))
+		return
+	}
+	field.SetString(strings.TrimRightFunc(field.String(), unicode.IsSpace))
+}
+
+// toLower lower cases the field's value.
+func toLower(ctx context.Context, fl FieldLevel) {
+	field := fl.Field()
+
+	if field.Kind() == reflect.String {
+		field.SetString(strings.ToLower(field.String()))
+	}
+}
+
+// toUpper upper cases the field's value.
+func toUpper(ctx context.Context, fl FieldLevel) {
+	field := fl.Field()
+
+	if field.Kind() == reflect.String {
+		field.SetString(strings.ToUpper(field.String()))
+	}
+}
+
+// collapseSpace replaces every run of white space with a single space.
+func collapseSpace(ctx context.Context, fl FieldLevel) {
+	field := fl.Field()
+
+	if field.Kind() != reflect.String {
+		return
+	}
+
+	s := field.String()
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	inSpace := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if !inSpace {
+				b.WriteByte(' ')
+			}
+			inSpace = true
+			continue
+		}
+		inSpace = false
+		b.WriteRune(r)
+	}
+
+	field.SetString(b.String())
+}
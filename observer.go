@@ -0,0 +1,141 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Observer is notified as validations run, for exporting metrics or tracing,
+// see WithObserver. Its methods are called synchronously by the goroutine
+// validating, so must be safe for concurrent use and should return quickly.
+type Observer interface {
+	// ObserveStruct is called once a struct, top level or nested, has been validated.
+	ObserveStruct(ctx context.Context, e StructEvent)
+
+	// ObserveValidation is called after each validation function has run.
+	ObserveValidation(ctx context.Context, e ValidationEvent)
+
+	// ObserveStructLevel is called after each struct level function has run.
+	ObserveStructLevel(ctx context.Context, e StructLevelEvent)
+}
+
+// StructEvent describes the validation of a struct.
+type StructEvent struct {
+	Type reflect.Type
+
+	// Namespace is the namespace of the struct eg. User.Address, or the name
+	// of the struct for the top level struct.
+	Namespace string
+
+	Start    time.Time
+	Duration time.Duration
+
+	// Errors is the number of errors found validating the struct, its fields
+	// and nested structs.
+	Errors int
+}
+
+// ValidationEvent describes a single run of a validation function.
+type ValidationEvent struct {
+	// Tag is the validation's tag and Alias the alias it was expanded from, if any.
+	Tag   string
+	Alias string
+	Param string
+
+	// Namespace is the namespace of the field validated eg. User.Addresses[0].City,
+	// empty when validating a variable.
+	Namespace string
+
+	Start    time.Time
+	Duration time.Duration
+
+	// Valid is the result returned by the validation function.
+	Valid bool
+}
+
+// StructLevelEvent describes a single run of a struct level function.
+type StructLevelEvent struct {
+	Type      reflect.Type
+	Namespace string
+
+	Start    time.Time
+	Duration time.Duration
+
+	// Errors is the number of errors the function reported.
+	Errors int
+}
+
+// observeValidation runs the validation function of ct on the current field,
+// notifying the observer and recording it in the trace.
+func (v *validate) observeValidation(ctx context.Context, ct *cTag, ns []byte) bool {
+	if v.v.observer == nil {
+		cf := v.cf
+		ok := ct.fn(ctx, v)
+		v.traceValidation(ct, ns, cf, ok)
+		return ok
+	}
+
+	cf := v.cf
+	start := time.Now()
+	ok := ct.fn(ctx, v)
+
+	e := ValidationEvent{
+		Tag:       ct.tag,
+		Param:     ct.param,
+		Namespace: namespaceString(ns, cf.altName),
+		Start:     start,
+		Duration:  time.Since(start),
+		Valid:     ok,
+	}
+
+	if ct.hasAlias {
+		e.Alias = ct.aliasTag
+	}
+
+	if v.trace != nil {
+		v.traceValidation(ct, ns, cf, ok)
+	}
+
+	v.v.observer.ObserveValidation(ctx, e)
+	return ok
+}
+
+func (v *validate) observeStructLevel(ctx context.Context, cs *cStruct, typ reflect.Type, ns []byte) {
+	errs := len(v.errs)
+	start := time.Now()
+
+	cs.fn(ctx, v)
+
+	e := StructLevelEvent{
+		Type:      typ,
+		Namespace: namespaceString(ns, ""),
+		Start:     start,
+		Duration:  time.Since(start),
+		Errors:    len(v.errs) - errs,
+	}
+
+	if v.trace != nil {
+		v.trace.Steps = append(v.trace.Steps, TraceStep{
+			Kind:      TraceStructLevel,
+			Namespace: e.Namespace,
+			Type:      typ,
+			Errors:    e.Errors,
+		})
+	}
+
+	if v.v.observer != nil {
+		v.v.observer.ObserveStructLevel(ctx, e)
+	}
+}
+
+// namespaceString returns the namespace of the field name within ns.
+func namespaceString(ns []byte, name string) string {
+	if len(name) > 0 {
+		return string(ns) + name
+	}
+	if n := len(ns); n > 0 && ns[n-1] == '.' {
+		return string(ns[:n-1])
+	}
+	return string(ns)
+}
@@ -1,5 +1,7 @@
 package validator
 
+import ut "github.com/go-playground/universal-translator"
+
 // Option represents a configurations option to be applied to validator during initialization.
 type Option func(*Validate)
 
@@ -38,3 +40,101 @@ func WithTagNameFuncBlankOmit() Option {
 		v.omitBlankFieldNames = true
 	}
 }
+
+// WithModifiers enables the modifier phase, in which the tags found under the
+// modifier tag name, 'mod' by default, normalize fields in place before any
+// validation runs eg.
+//
+//	Email string `mod:"trim,lower" validate:"required,email"`
+//
+// Modifiers only apply to addressable values, so a pointer to the struct must be
+// passed to Struct and its variants; structs passed by value are validated as is.
+func WithModifiers() Option {
+	return func(v *Validate) {
+		v.hasModifiers = true
+	}
+}
+
+// WithDefaults enables populating empty fields from the literal found under the
+// default tag name, 'default' by default, before any validation runs eg.
+//
+//	Port    int           `default:"8080" validate:"gte=1,lte=65535"`
+//	Timeout time.Duration `default:"30s"`
+//	Hosts   []string      `default:"a.example.com,b.example.com"`
+//
+// Defaults are applied, ahead of any modifiers, during the same phase enabled by
+// WithModifiers and so have the same requirement that a pointer to the struct be
+// passed to Struct and its variants.
+//
+// A field is populated when nil, for pointers and slices, or when it has its
+// type's zero value, following the field's leading omitnil or omitzero tag
+// when present so that a default is applied exactly when that tag would have
+// skipped validation.
+func WithDefaults() Option {
+	return func(v *Validate) {
+		v.hasDefaults = true
+	}
+}
+
+// WithStrictTags enables checking, when a struct is first cached, that each
+// validation is used on a kind of field it supports and with a valid parameter,
+// panicking otherwise eg. both of
+//
+//	Name   string `validate:"min=abc"`
+//	Active bool   `validate:"len=1"`
+//
+// Without this option only the numeric and value parameters of the baked in
+// validations, such as that of min above, are checked and other mistakes only
+// panic once the field is validated, which may never happen in tests for
+// optional fields. Checks use the metadata
+// returned by Validations, that of the baked in validations and of custom
+// validations registered using RegisterValidationWithInfo with Kinds or a
+// ParamSchema. The kinds of fields of interface types, types implementing
+// Valuer or registered with RegisterCustomTypeFunc are not checked, and their
+// parameters, as well as those of tags passed to Var and its variants, are
+// checked against the field's type when validating, an invalid parameter
+// panicking then.
+func WithStrictTags() Option {
+	return func(v *Validate) {
+		v.strictTags = true
+	}
+}
+
+// WithTagCacheSize bounds the number of tags cached for Var and its variants to
+// size, evicting the least recently used, for applications building tags
+// dynamically which would otherwise grow the cache without bound. A size of
+// zero or less leaves the cache unbounded, the default.
+func WithTagCacheSize(size int) Option {
+	return func(v *Validate) {
+		if size > 0 {
+			v.tagCache.max = size
+		}
+	}
+}
+
+// WithObserver notifies o as structs, validation functions and struct level
+// functions run, eg. to export per tag latencies and failure counts or to spot
+// slow custom validations. Validating without an observer costs nothing extra.
+func WithObserver(o Observer) Option {
+	return func(v *Validate) {
+		v.observer = o
+	}
+}
+
+// WithTranslators registers uni's translators as those errors are translated
+// with by TranslateCtx, which picks the translator of the first of the locales
+// resolved from the context uni has, falling back to uni's fallback translator.
+func WithTranslators(uni *ut.UniversalTranslator) Option {
+	return func(v *Validate) {
+		v.uni = uni
+	}
+}
+
+// WithLocaleResolver overrides how TranslateCtx resolves the preferred locales
+// from the context, by default those set using ContextWithLocales eg. to read
+// them from a request scoped user or tenant.
+func WithLocaleResolver(fn LocaleResolver) Option {
+	return func(v *Validate) {
+		v.localeResolver = fn
+	}
+}
@@ -0,0 +1,187 @@
+package validator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// emptyParam is shared by all tags without a parameter.
+var emptyParam = parseParam("")
+
+// newParam returns s parsed, sharing emptyParam when s is empty.
+func newParam(s string) *Param {
+	if len(s) == 0 {
+		return emptyParam
+	}
+	return parseParam(s)
+}
+
+// ParsedParam returns the param for validation against fl's current field,
+// parsed when the tag was cached to avoid parsing it on every validation or
+// parsed from fl.Param() for other implementations of FieldLevel.
+func ParsedParam(fl FieldLevel) *Param {
+	if v, ok := fl.(*validate); ok && v.ct != nil && v.ct.parsedParam != nil {
+		return v.ct.parsedParam
+	}
+	return newParam(fl.Param())
+}
+
+// Param is a validation's parameter parsed once when its tag is cached, so
+// validations need not parse it on every call. Each accessor returns the
+// parameter as that type or the error encountered parsing it as one.
+type Param struct {
+	raw string
+
+	i    int64
+	u    uint64
+	f64  float64
+	f32  float64
+	d    time.Duration
+	b    bool
+	vals []string
+	set  map[string]struct{}
+
+	iErr   error
+	uErr   error
+	f64Err error
+	f32Err error
+	dErr   error
+	bErr   error
+}
+
+// parseParam parses s as each of the types a validation may read it as.
+func parseParam(s string) *Param {
+	p := &Param{raw: s}
+
+	p.i, p.iErr = strconv.ParseInt(s, 0, 64)
+	p.u, p.uErr = strconv.ParseUint(s, 0, 64)
+	p.f64, p.f64Err = strconv.ParseFloat(s, 64)
+	p.f32, p.f32Err = strconv.ParseFloat(s, 32)
+	p.b, p.bErr = strconv.ParseBool(s)
+
+	if p.d, p.dErr = time.ParseDuration(s); p.dErr != nil {
+		// attempt parsing as an integer assuming nanosecond precision
+		p.d, p.dErr = time.Duration(p.i), p.iErr
+	}
+
+	if len(s) > 0 {
+		p.vals = splitParamsRegex().FindAllString(s, -1)
+		for i := 0; i < len(p.vals); i++ {
+			p.vals[i] = strings.ReplaceAll(p.vals[i], "'", "")
+		}
+
+		if len(p.vals) > 1 {
+			p.set = make(map[string]struct{}, len(p.vals))
+			for _, val := range p.vals {
+				p.set[val] = struct{}{}
+			}
+		}
+	}
+	return p
+}
+
+// String returns the parameter as written in the tag.
+func (p *Param) String() string {
+	return p.raw
+}
+
+// Int returns the parameter as an int64, accepting base prefixes eg. 0x1F.
+func (p *Param) Int() (int64, error) {
+	return p.i, p.iErr
+}
+
+// Uint returns the parameter as a uint64, accepting base prefixes eg. 0x1F.
+func (p *Param) Uint() (uint64, error) {
+	return p.u, p.uErr
+}
+
+// Float64 returns the parameter as a float64.
+func (p *Param) Float64() (float64, error) {
+	return p.f64, p.f64Err
+}
+
+// Float32 returns the parameter parsed as a float32, for comparison with the
+// float64 a reflect.Value returns for float32 fields.
+func (p *Param) Float32() (float64, error) {
+	return p.f32, p.f32Err
+}
+
+// Duration returns the parameter as a time.Duration, either a duration eg.
+// 1h30m or an integer number of nanoseconds.
+func (p *Param) Duration() (time.Duration, error) {
+	return p.d, p.dErr
+}
+
+// Bool returns the parameter as a bool.
+func (p *Param) Bool() (bool, error) {
+	return p.b, p.bErr
+}
+
+// Values returns the parameter split into a list of space separated values,
+// values containing spaces being enclosed in single quotes eg. "a 'b c'". The
+// returned slice is shared and must not be modified.
+func (p *Param) Values() []string {
+	return p.vals
+}
+
+// Contains returns whether s is one of the parameter's Values.
+func (p *Param) Contains(s string) bool {
+	if p.set == nil {
+		return len(p.vals) == 1 && p.vals[0] == s
+	}
+
+	_, ok := p.set[s]
+	return ok
+}
+
+// The accessors below are used by the baked in validations, whose parameters
+// are checked against the field's type when the tag is cached, or before
+// validating when the type is not known until then, and so panic only for
+// parameters which escaped those checks.
+
+// asInt returns the parameter as an int64
+// or panics if it can't convert
+func (p *Param) asInt() int64 {
+	panicIf(p.iErr)
+	return p.i
+}
+
+// asIntFromType returns the parameter as an int64 for a field of type t,
+// parsing durations for time.Duration, or panics if it can't convert
+func (p *Param) asIntFromType(t reflect.Type) int64 {
+	if t == timeDurationType {
+		panicIf(p.dErr)
+		return int64(p.d)
+	}
+	return p.asInt()
+}
+
+// asUint returns the parameter as a uint64
+// or panics if it can't convert
+func (p *Param) asUint() uint64 {
+	panicIf(p.uErr)
+	return p.u
+}
+
+// asFloat64 returns the parameter as a float64
+// or panics if it can't convert
+func (p *Param) asFloat64() float64 {
+	panicIf(p.f64Err)
+	return p.f64
+}
+
+// asFloat32 returns the parameter as a float32
+// or panics if it can't convert
+func (p *Param) asFloat32() float64 {
+	panicIf(p.f32Err)
+	return p.f32
+}
+
+// asBool returns the parameter as a bool
+// or panics if it can't convert
+func (p *Param) asBool() bool {
+	panicIf(p.bErr)
+	return p.b
+}
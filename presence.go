@@ -0,0 +1,161 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Present records the object keys and array elements present in a JSON document
+// for use with StructPresent.
+type Present struct {
+	fields map[string]*Present
+	folded map[string]*Present
+	items  []*Present
+}
+
+var _ scope = new(Present)
+
+// PresentFromJSON returns the keys present in the JSON document data, recursing
+// into nested objects and arrays.
+func PresentFromJSON(data json.RawMessage) (*Present, error) {
+	var doc interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return newPresent(doc), nil
+}
+
+func newPresent(doc interface{}) *Present {
+	p := new(Present)
+
+	switch t := doc.(type) {
+	case map[string]interface{}:
+		p.fields = make(map[string]*Present, len(t))
+		keys := make([]string, 0, len(t))
+		for k, v := range t {
+			p.fields[k] = newPresent(v)
+			keys = append(keys, k)
+		}
+
+		// index the keys case-insensitively once, taking the first of the keys
+		// in order should several differ only by case
+		slices.Sort(keys)
+		p.folded = make(map[string]*Present, len(keys))
+		for _, k := range keys {
+			f := foldName(k)
+			if _, ok := p.folded[f]; !ok {
+				p.folded[f] = p.fields[k]
+			}
+		}
+
+	case []interface{}:
+		p.items = make([]*Present, len(t))
+		for i, v := range t {
+			p.items[i] = newPresent(v)
+		}
+	}
+	return p
+}
+
+// Has returns whether key was present in the JSON object.
+func (p *Present) Has(key string) bool {
+	_, ok := p.fields[key]
+	return ok
+}
+
+// Field returns the keys present within the value of key, or nil when key was not present.
+func (p *Present) Field(key string) *Present {
+	return p.fields[key]
+}
+
+// Index returns the keys present within the array element at i, or nil when there is no such element.
+func (p *Present) Index(i int) *Present {
+	if i < 0 || i >= len(p.items) {
+		return nil
+	}
+	return p.items[i]
+}
+
+func (p *Present) field(_, altName string) (scope, bool) {
+	if child, ok := p.fields[altName]; ok {
+		return child, true
+	}
+
+	// as with encoding/json, fall back to a case-insensitive match of the name
+	if child, ok := p.folded[foldName(altName)]; ok {
+		return child, true
+	}
+	return nil, false
+}
+
+// foldName returns name case folded the way encoding/json matches object keys
+// to field names.
+func foldName(name string) string {
+	out := make([]byte, 0, len(name))
+	for i := 0; i < len(name); {
+		if c := name[i]; c < utf8.RuneSelf {
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			out = append(out, c)
+			i++
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(name[i:])
+		out = utf8.AppendRune(out, unicode.ToUpper(unicode.ToLower(r)))
+		i += n
+	}
+	return string(out)
+}
+
+func (p *Present) index(i int) (scope, bool) {
+	if i >= len(p.items) {
+		return nil, false
+	}
+	return p.items[i], true
+}
+
+func (p *Present) key(k string) (scope, bool) {
+	if child, ok := p.fields[k]; ok {
+		return child, true
+	}
+	return nil, false
+}
+
+// StructPresent validates only the fields of s present in a JSON document, as
+// returned by PresentFromJSON, which is useful for JSON merge patch requests
+// where absent keys are left unchanged.
+//
+// Fields are matched to keys using the name returned by the function registered
+// with RegisterTagNameFunc, falling back to the struct field name, exactly or
+// else case-insensitively as encoding/json does, with
+// presence applied recursively to nested structs, dived slice and array
+// elements and map values. Fields which are absent are skipped entirely, so
+// 'required' only fails where a key was sent with an empty value, whilst every
+// rule applies to present fields. A nil present validates all fields.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructPresent(s interface{}, present *Present) error {
+	return v.StructPresentCtx(context.Background(), s, present)
+}
+
+// StructPresentCtx does the same as StructPresent and allows passing of contextual
+// validation information via context.Context.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructPresentCtx(ctx context.Context, s interface{}, present *Present) error {
+	if present == nil {
+		return v.structScopedCtx(ctx, s, nil)
+	}
+	return v.structScopedCtx(ctx, s, present)
+}
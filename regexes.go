@@ -171,3 +171,69 @@ var (
 	spicedbTypeRegex           = lazyRegexCompile(spicedbTypeRegexString)
 	einRegex                   = lazyRegexCompile(einRegexString)
 )
+
+// bakedInRegexStrings maps the baked in validations of string values which are
+// entirely described by a regular expression to it, so that Describe can expose
+// the pattern to schema generators.
+var bakedInRegexStrings = map[string]string{
+	"alpha":                     alphaRegexString,
+	"alphaspace":                alphaSpaceRegexString,
+	"alphanum":                  alphaNumericRegexString,
+	"alphanumspace":             alphaNumericSpaceRegexString,
+	"alphaunicode":              alphaUnicodeRegexString,
+	"alphanumunicode":           alphaUnicodeNumericRegexString,
+	"numeric":                   numericRegexString,
+	"number":                    numberRegexString,
+	"hexadecimal":               hexadecimalRegexString,
+	"hexcolor":                  hexColorRegexString,
+	"rgb":                       rgbRegexString,
+	"rgba":                      rgbaRegexString,
+	"hsl":                       hslRegexString,
+	"hsla":                      hslaRegexString,
+	"cmyk":                      cmykRegexString,
+	"e164":                      e164RegexString,
+	"email":                     emailRegexString,
+	"base32":                    base32RegexString,
+	"base64":                    base64RegexString,
+	"base64url":                 base64URLRegexString,
+	"base64rawurl":              base64RawURLRegexString,
+	"eth_addr":                  ethAddressRegexString,
+	"uuid":                      uUIDRegexString,
+	"uuid3":                     uUID3RegexString,
+	"uuid4":                     uUID4RegexString,
+	"uuid5":                     uUID5RegexString,
+	"uuid_rfc4122":              uUIDRFC4122RegexString,
+	"uuid3_rfc4122":             uUID3RFC4122RegexString,
+	"uuid4_rfc4122":             uUID4RFC4122RegexString,
+	"uuid5_rfc4122":             uUID5RFC4122RegexString,
+	"ulid":                      uLIDRegexString,
+	"md4":                       md4RegexString,
+	"md5":                       md5RegexString,
+	"sha256":                    sha256RegexString,
+	"sha384":                    sha384RegexString,
+	"sha512":                    sha512RegexString,
+	"ripemd128":                 ripemd128RegexString,
+	"ripemd160":                 ripemd160RegexString,
+	"tiger128":                  tiger128RegexString,
+	"tiger160":                  tiger160RegexString,
+	"tiger192":                  tiger192RegexString,
+	"ascii":                     aSCIIRegexString,
+	"printascii":                printableASCIIRegexString,
+	"multibyte":                 multibyteRegexString,
+	"latitude":                  latitudeRegexString,
+	"longitude":                 longitudeRegexString,
+	"hostname":                  hostnameRegexStringRFC952,
+	"hostname_rfc1123":          hostnameRegexStringRFC1123,
+	"html":                      hTMLRegexString,
+	"html_encoded":              hTMLEncodedRegexString,
+	"url_encoded":               uRLEncodedRegexString,
+	"jwt":                       jWTRegexString,
+	"bic_iso_9362_2014":         bic2014RegexString,
+	"bic":                       bic2022RegexString,
+	"semver":                    semverRegexString,
+	"cve":                       cveRegexString,
+	"mongodb":                   mongodbIdRegexString,
+	"mongodb_connection_string": mongodbConnStringRegexString,
+	"cron":                      cronRegexString,
+	"ein":                       einRegexString,
+}
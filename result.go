@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+)
+
+// Result holds the errors of a validation run by StructInto. Reusing a Result
+// reuses the storage of its errors, so validating in a loop stops allocating
+// for errors once the Result has grown to hold them, other than a single buffer
+// for the namespaces of each run with errors.
+//
+// The errors of a Result, including those returned by StructInto, are only
+// valid until the Result is next used or Reset and must be copied to be kept.
+// The strings they return, such as their namespaces, remain valid.
+type Result struct {
+	errs   ValidationErrors
+	fields []fieldError
+	buf    []byte
+	bufCap int // capacity the namespaces needed, for sizing the next run's buf
+}
+
+// Errors returns the errors of the last validation, nil when it passed.
+func (r *Result) Errors() ValidationErrors {
+	if len(r.errs) == 0 {
+		return nil
+	}
+	return r.errs
+}
+
+// Valid returns whether the last validation passed.
+func (r *Result) Valid() bool {
+	return len(r.errs) == 0
+}
+
+// Reset clears the errors, keeping their storage for reuse.
+func (r *Result) Reset() {
+	clear(r.errs)
+	clear(r.fields)
+
+	r.errs = r.errs[:0]
+	r.fields = r.fields[:0]
+
+	// namespace strings point into buf and may still be held by the caller, so
+	// it is dropped rather than overwritten by the next run
+	r.bufCap = max(r.bufCap, len(r.buf))
+	r.buf = nil
+}
+
+// newError returns a fieldError stored in the Result.
+func (r *Result) newError() *fieldError {
+	if len(r.fields) < cap(r.fields) {
+		r.fields = r.fields[:len(r.fields)+1]
+	} else {
+		r.fields = append(r.fields, fieldError{})
+	}
+	return &r.fields[len(r.fields)-1]
+}
+
+// StructInto validates a structs exposed fields like StructCtx, storing the
+// errors in r rather than allocating them.
+//
+// It returns InvalidValidationError for bad values passed in and nil or the
+// ValidationErrors of r, which are only valid until r is reused, otherwise.
+func (v *Validate) StructInto(ctx context.Context, s interface{}, r *Result) (err error) {
+	r.Reset()
+
+	val := reflect.ValueOf(s)
+	top := val
+
+	if val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type().ConvertibleTo(timeType) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	// good to validate
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = false
+	vd.result = r
+	vd.errs = r.errs
+	vd.errBuf = r.buf
+
+	if (v.hasModifiers || v.hasDefaults) && val.CanAddr() {
+		vd.modifyStruct(ctx, val)
+	}
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	r.errs = vd.errs
+	r.buf = vd.errBuf
+
+	if len(r.errs) > 0 {
+		err = r.errs
+	}
+
+	vd.result = nil
+	vd.errs = nil
+	vd.errBuf = nil
+	v.pool.Put(vd)
+
+	return
+}
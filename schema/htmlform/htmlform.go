@@ -0,0 +1,275 @@
+// Package htmlform derives HTML5 form constraint attributes from the validation
+// rules of Go structs, so server rendered forms get browser side validation
+// matching the server's.
+//
+// Fields are keyed by the names returned by the function registered with
+// RegisterTagNameFunc, nested structs by their '.' separated path, and the
+// attributes can be written directly into an input element from html/template:
+//
+//	attrs := htmlform.Attrs(validate, reflect.TypeOf(User{}))
+//
+//	<input name="email" {{index .Attrs "email"}}>
+//
+// Only rules with a browser equivalent are mapped, the server must still
+// validate the submitted form. Where several rules map to a 'pattern' only the
+// first is used.
+package htmlform
+
+import (
+	"html/template"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	splitParamsRegex = regexp.MustCompile(`'[^']*'|\S+`)
+
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// attrs are the constraint attributes of a single input in the order they are written.
+type attrs struct {
+	typ       string
+	required  bool
+	minLength string
+	maxLength string
+	min       string
+	max       string
+	step      string
+	pattern   string
+}
+
+// Attrs returns the input constraint attributes of each field of the struct
+// typ, and of the fields of nested structs, keyed by name eg.
+// 'required minlength="2" maxlength="50"'.
+func Attrs(v *validator.Validate, typ reflect.Type) map[string]template.HTMLAttr {
+	m := make(map[string]template.HTMLAttr)
+	collect(m, v, typ, "", make(map[reflect.Type]bool))
+	return m
+}
+
+func collect(m map[string]template.HTMLAttr, v *validator.Validate, typ reflect.Type, prefix string, seen map[reflect.Type]bool) {
+	typ = indirect(typ)
+	if seen[typ] {
+		return
+	}
+
+	seen[typ] = true
+	defer delete(seen, typ)
+
+	for _, f := range v.Describe(typ).Fields {
+		if f.AltName == "-" || len(f.AltName) == 0 {
+			continue
+		}
+
+		ft := indirect(f.Type)
+
+		if f.Anonymous && f.AltName == f.Name && ft.Kind() == reflect.Struct {
+			// embedded structs are flattened
+			collect(m, v, ft, prefix, seen)
+			continue
+		}
+
+		name := prefix + f.AltName
+
+		if ft.Kind() == reflect.Struct && ft != timeType {
+			collect(m, v, ft, name+".", seen)
+			continue
+		}
+
+		if a, ok := fieldAttrs(ft, f.Rules); ok {
+			m[name] = a.attr()
+		}
+	}
+}
+
+// fieldAttrs maps rules to the attributes of an input for a value of typ,
+// returning false for types which cannot be entered using an input.
+func fieldAttrs(typ reflect.Type, rules []validator.Rule) (a attrs, ok bool) {
+	var isString, isInt bool
+
+	switch typ.Kind() {
+	case reflect.String:
+		isString = true
+
+	case reflect.Bool:
+		a.typ = "checkbox"
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if typ == durationType {
+			return a, false
+		}
+		a.typ = "number"
+		isInt = true
+
+	case reflect.Float32, reflect.Float64:
+		a.typ = "number"
+		a.step = "any"
+
+	case reflect.Struct:
+		if typ != timeType {
+			return a, false
+		}
+
+	default:
+		return a, false
+	}
+
+	for _, r := range rules {
+		if r.Kind != validator.RuleValidation {
+			continue
+		}
+
+		if r.Tag == "required" {
+			a.required = true
+			continue
+		}
+
+		if isString {
+			a.stringRule(r)
+		} else if len(a.typ) > 0 && a.typ != "checkbox" {
+			a.numberRule(r, isInt)
+		}
+	}
+	return a, true
+}
+
+func (a *attrs) stringRule(r validator.Rule) {
+	switch r.Tag {
+	case "email":
+		a.typ = "email"
+		return
+
+	case "url", "http_url":
+		a.typ = "url"
+		return
+
+	case "number", "numeric":
+		// kept as text so the value is submitted as typed, the pattern applies
+
+	case "oneof":
+		vals := splitParamsRegex.FindAllString(r.Param, -1)
+		for i := range vals {
+			vals[i] = regexp.QuoteMeta(strings.ReplaceAll(vals[i], "'", ""))
+		}
+		a.setPattern("(?:" + strings.Join(vals, "|") + ")")
+		return
+	}
+
+	if len(r.Pattern) > 0 {
+		// the browser anchors patterns itself and has no equivalent to
+		// inline flags, so only anchored patterns without flags are usable
+		if strings.HasPrefix(r.Pattern, "^") && strings.HasSuffix(r.Pattern, "$") && !strings.Contains(r.Pattern, "(?") {
+			a.setPattern(strings.ReplaceAll(r.Pattern, `\x{`, `\u{`))
+		}
+		return
+	}
+
+	n, err := strconv.Atoi(r.Param)
+	if err != nil {
+		return
+	}
+
+	switch r.Tag {
+	case "min", "gte":
+		a.minLength = strconv.Itoa(n)
+	case "max", "lte":
+		a.maxLength = strconv.Itoa(n)
+	case "gt":
+		a.minLength = strconv.Itoa(n + 1)
+	case "lt":
+		a.maxLength = strconv.Itoa(n - 1)
+	case "len":
+		a.minLength = strconv.Itoa(n)
+		a.maxLength = a.minLength
+	}
+}
+
+func (a *attrs) numberRule(r validator.Rule, isInt bool) {
+	if _, err := strconv.ParseFloat(r.Param, 64); err != nil {
+		return
+	}
+
+	switch r.Tag {
+	case "min", "gte":
+		a.min = r.Param
+	case "max", "lte":
+		a.max = r.Param
+	case "eq":
+		a.min = r.Param
+		a.max = r.Param
+	case "gt", "lt":
+		if !isInt {
+			// exclusive bounds have no equivalent for decimals
+			return
+		}
+
+		n, err := strconv.ParseInt(r.Param, 10, 64)
+		if err != nil {
+			return
+		}
+
+		if r.Tag == "gt" {
+			a.min = strconv.FormatInt(n+1, 10)
+		} else {
+			a.max = strconv.FormatInt(n-1, 10)
+		}
+	}
+}
+
+func (a *attrs) setPattern(pattern string) {
+	if len(a.pattern) == 0 {
+		a.pattern = pattern
+	}
+}
+
+// attr returns the attributes with their values escaped.
+func (a attrs) attr() template.HTMLAttr {
+	var b strings.Builder
+
+	write := func(name, val string) {
+		if len(val) == 0 {
+			return
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(name)
+		b.WriteString(`="`)
+		b.WriteString(template.HTMLEscapeString(val))
+		b.WriteByte('"')
+	}
+
+	write("type", a.typ)
+
+	if a.required {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString("required")
+	}
+
+	write("minlength", a.minLength)
+	write("maxlength", a.maxLength)
+	write("min", a.min)
+	write("max", a.max)
+	write("step", a.step)
+	write("pattern", a.pattern)
+
+	return template.HTMLAttr(b.String())
+}
+
+func indirect(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
@@ -0,0 +1,88 @@
+package htmlform
+
+import (
+	"bytes"
+	"html/template"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/go-playground/assert/v2"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Address struct {
+	Street string `form:"street" validate:"required,max=100"`
+	Zip    string `form:"zip" validate:"omitempty,len=5,number"`
+}
+
+type Audit struct {
+	Reason string `form:"reason" validate:"required"`
+}
+
+type Signup struct {
+	Audit
+	Name     string    `form:"name" validate:"required,min=2,max=50"`
+	Email    string    `form:"email" validate:"required,email"`
+	Website  string    `form:"website" validate:"omitempty,url"`
+	Age      int       `form:"age" validate:"gte=18,lt=130"`
+	Score    float64   `form:"score" validate:"gt=0,lte=9.5"`
+	Role     string    `form:"role" validate:"oneof=admin 'power user' a.b"`
+	Code     string    `form:"code" validate:"alphanum"`
+	ULID     string    `form:"ulid" validate:"ulid"`
+	Terms    bool      `form:"terms" validate:"required"`
+	Born     time.Time `form:"born" validate:"required"`
+	Address  Address   `form:"address"`
+	Tags     []string  `form:"tags" validate:"dive,required"`
+	Password string    `form:"password" validate:"required,eqfield=Confirm"`
+	Confirm  string    `form:"confirm"`
+	Next     *Signup   `form:"next"`
+	Ignored  string    `form:"-"`
+}
+
+func TestAttrs(t *testing.T) {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("form")
+	})
+
+	attrs := Attrs(validate, reflect.TypeOf(&Signup{}))
+
+	Equal(t, attrs["reason"], template.HTMLAttr(`required`))
+	Equal(t, attrs["name"], template.HTMLAttr(`required minlength="2" maxlength="50"`))
+	Equal(t, attrs["email"], template.HTMLAttr(`type="email" required`))
+	Equal(t, attrs["website"], template.HTMLAttr(`type="url"`))
+	Equal(t, attrs["age"], template.HTMLAttr(`type="number" min="18" max="129"`))
+	Equal(t, attrs["score"], template.HTMLAttr(`type="number" max="9.5" step="any"`))
+	Equal(t, attrs["role"], template.HTMLAttr(`pattern="(?:admin|power user|a\.b)"`))
+	Equal(t, attrs["code"], template.HTMLAttr(`pattern="^[a-zA-Z0-9]+$"`))
+	Equal(t, attrs["ulid"], template.HTMLAttr(``))
+	Equal(t, attrs["terms"], template.HTMLAttr(`type="checkbox" required`))
+	Equal(t, attrs["born"], template.HTMLAttr(`required`))
+	Equal(t, attrs["address.street"], template.HTMLAttr(`required maxlength="100"`))
+	Equal(t, attrs["address.zip"], template.HTMLAttr(`minlength="5" maxlength="5" pattern="^[0-9]+$"`))
+	Equal(t, attrs["password"], template.HTMLAttr(`required`))
+	Equal(t, attrs["confirm"], template.HTMLAttr(``))
+
+	_, ok := attrs["tags"]
+	Equal(t, ok, false)
+
+	_, ok = attrs["next.name"]
+	Equal(t, ok, false)
+
+	_, ok = attrs["Ignored"]
+	Equal(t, ok, false)
+
+	tmpl := template.Must(template.New("form").Parse(`<input name="name" {{index . "name"}}>`))
+
+	var buf bytes.Buffer
+	Equal(t, tmpl.Execute(&buf, attrs), nil)
+	Equal(t, buf.String(), `<input name="name" required minlength="2" maxlength="50">`)
+
+	// values are escaped
+	quoted := Attrs(validate, reflect.TypeOf(struct {
+		Q string `form:"q" validate:"oneof=a\"b"`
+	}{}))["q"]
+	Equal(t, quoted, template.HTMLAttr(`pattern="(?:a&#34;b)"`))
+}
@@ -0,0 +1,342 @@
+// Package sqlcheck converts the validation rules of Go structs into NOT NULL
+// and CHECK constraints, so the database enforces the same invariants as the
+// application.
+//
+// Columns are named using the names returned by the function registered with
+// RegisterTagNameFunc, so register one returning the column name:
+//
+//	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+//		return fld.Tag.Get("db")
+//	})
+//
+//	table := sqlcheck.Generate(validate, reflect.TypeOf(User{}), sqlcheck.Postgres)
+//
+//	for _, c := range table.Columns {
+//		fmt.Printf("%s %s\n", c.Name, c.Constraints())
+//	}
+//
+// The following are supported, other validations are left to the application:
+//
+//	required                                    NOT NULL, and <> '' on strings, <> 0 on numbers and true on bools
+//	min, max, len, gt, gte, lt, lte             char_length checks on strings, bounds on numbers
+//	eq, ne                                      comparisons with the param
+//	oneof                                       IN (...)
+//	eqfield, nefield, gtfield, gtefield, ...    comparisons between columns
+//
+// Checks following omitempty also accept the empty string or zero. As in SQL
+// a NULL value satisfies every CHECK constraint. As with the validator, required
+// on a pointer field only rules out NULL.
+package sqlcheck
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	splitParamsRegex = regexp.MustCompile(`'[^']*'|\S+`)
+	identRegex       = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+	numberRegex      = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// Dialect is the SQL dialect constraints are written in.
+type Dialect uint8
+
+// Dialects
+const (
+	Postgres Dialect = iota
+	SQLite
+)
+
+// Column holds the constraints of a single column.
+type Column struct {
+	// Name is the column name and Field the struct field it was derived from.
+	Name  string
+	Field string
+
+	NotNull bool
+
+	// Checks are the boolean expressions of the column's CHECK constraints.
+	Checks []string
+}
+
+// Table holds the constraints derived from a struct.
+type Table struct {
+	Columns []Column
+
+	// Checks are the boolean expressions of CHECK constraints comparing columns.
+	Checks []string
+}
+
+var fieldOps = map[string]string{
+	"eqfield":  "=",
+	"nefield":  "<>",
+	"gtfield":  ">",
+	"gtefield": ">=",
+	"ltfield":  "<",
+	"ltefield": "<=",
+}
+
+var compareOps = map[string]string{
+	"eq":  "=",
+	"len": "=",
+	"ne":  "<>",
+	"gt":  ">",
+	"gte": ">=",
+	"min": ">=",
+	"lt":  "<",
+	"lte": "<=",
+	"max": "<=",
+}
+
+// Generate returns the constraints of the columns of the struct typ, flattening
+// embedded structs. Fields holding nested structs, slices or maps are skipped.
+func Generate(v *validator.Validate, typ reflect.Type, d Dialect) Table {
+	var t Table
+	g := generator{d: d}
+
+	g.collect(&t, v, typ)
+	return t
+}
+
+type generator struct {
+	d Dialect
+}
+
+func (g generator) collect(t *Table, v *validator.Validate, typ reflect.Type) {
+	tr := v.Describe(typ)
+
+	columns := make(map[string]string, len(tr.Fields))
+	for _, f := range tr.Fields {
+		columns[f.Name] = f.AltName
+	}
+
+	for _, f := range tr.Fields {
+		if f.AltName == "-" || len(f.AltName) == 0 {
+			continue
+		}
+
+		ft := indirect(f.Type)
+
+		if f.Anonymous && f.AltName == f.Name && ft.Kind() == reflect.Struct {
+			g.collect(t, v, ft)
+			continue
+		}
+
+		switch ft.Kind() {
+		case reflect.Struct:
+			if ft != timeType {
+				continue
+			}
+		case reflect.Slice, reflect.Array, reflect.Map, reflect.Interface, reflect.Chan, reflect.Func:
+			continue
+		}
+
+		col := Column{Name: f.AltName, Field: f.Name}
+		name := ident(f.AltName)
+
+		var empty, nonEmpty string
+		switch ft.Kind() {
+		case reflect.String:
+			empty, nonEmpty = name+" = ''", name+" <> ''"
+		case reflect.Bool:
+			empty, nonEmpty = "NOT "+name, name
+		case reflect.Struct:
+		default:
+			empty, nonEmpty = name+" = 0", name+" <> 0"
+		}
+
+		var omitEmpty bool
+
+		for _, r := range f.Rules {
+			switch r.Kind {
+			case validator.RuleOmitEmpty, validator.RuleOmitZero:
+				omitEmpty = true
+				continue
+
+			case validator.RuleValidation:
+			default:
+				continue
+			}
+
+			if r.Tag == "required" {
+				col.NotNull = true
+
+				// required also rules out the empty string and zero, other than
+				// on pointers where it only checks for nil
+				if len(nonEmpty) > 0 && f.Type.Kind() != reflect.Ptr {
+					col.Checks = append(col.Checks, nonEmpty)
+				}
+				continue
+			}
+
+			if op, ok := fieldOps[r.Tag]; ok {
+				other, ok := columns[r.Param]
+				if !ok {
+					continue
+				}
+
+				check := name + " " + op + " " + ident(other)
+				if omitEmpty && len(empty) > 0 {
+					check = empty + " OR " + check
+				}
+				t.Checks = append(t.Checks, check)
+				continue
+			}
+
+			check, ok := g.check(name, ft, r)
+			if !ok {
+				continue
+			}
+
+			if omitEmpty && len(empty) > 0 {
+				check = empty + " OR " + check
+			}
+			col.Checks = append(col.Checks, check)
+		}
+
+		if col.NotNull || len(col.Checks) > 0 {
+			t.Columns = append(t.Columns, col)
+		}
+	}
+}
+
+// check returns the expression enforcing r on the column name of type typ.
+func (g generator) check(name string, typ reflect.Type, r validator.Rule) (string, bool) {
+	switch typ.Kind() {
+	case reflect.String:
+		if r.Tag == "oneof" {
+			vals := splitParamsRegex.FindAllString(r.Param, -1)
+			for i := range vals {
+				vals[i] = quote(strings.ReplaceAll(vals[i], "'", ""))
+			}
+			return name + " IN (" + strings.Join(vals, ", ") + ")", true
+		}
+
+		op, ok := compareOps[r.Tag]
+		if !ok {
+			return "", false
+		}
+
+		if r.Tag == "eq" || r.Tag == "ne" {
+			// compares the value itself rather than its length
+			return name + " " + op + " " + quote(r.Param), true
+		}
+
+		if _, err := strconv.Atoi(r.Param); err != nil {
+			return "", false
+		}
+		return g.length(name) + " " + op + " " + r.Param, true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if r.Tag == "oneof" {
+			vals := splitParamsRegex.FindAllString(r.Param, -1)
+			for _, val := range vals {
+				if !numberRegex.MatchString(val) {
+					return "", false
+				}
+			}
+			return name + " IN (" + strings.Join(vals, ", ") + ")", true
+		}
+
+		op, ok := compareOps[r.Tag]
+		if !ok {
+			return "", false
+		}
+
+		// only plain numeric literals, which rules out Inf, NaN and hex
+		if !numberRegex.MatchString(r.Param) {
+			return "", false
+		}
+		return name + " " + op + " " + r.Param, true
+	}
+	return "", false
+}
+
+func (g generator) length(name string) string {
+	if g.d == SQLite {
+		return "length(" + name + ")"
+	}
+	return "char_length(" + name + ")"
+}
+
+// Constraints returns the column constraints to append to the column's
+// definition in a CREATE TABLE statement eg. NOT NULL CHECK (char_length(name) <= 50).
+func (c Column) Constraints() string {
+	var b strings.Builder
+
+	if c.NotNull {
+		b.WriteString("NOT NULL")
+	}
+
+	for _, check := range c.Checks {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "CHECK (%s)", check)
+	}
+	return b.String()
+}
+
+// Constraints returns the table constraints to add to a CREATE TABLE statement.
+func (t Table) Constraints() []string {
+	s := make([]string, len(t.Checks))
+	for i, check := range t.Checks {
+		s[i] = "CHECK (" + check + ")"
+	}
+	return s
+}
+
+// AlterTable returns the Postgres statements adding every constraint to an
+// existing table, SQLite does not support adding constraints to a table.
+func (t Table) AlterTable(table string) []string {
+	var stmts []string
+
+	prefix := "ALTER TABLE " + ident(table)
+
+	for _, c := range t.Columns {
+		if c.NotNull {
+			stmts = append(stmts, prefix+" ALTER COLUMN "+ident(c.Name)+" SET NOT NULL;")
+		}
+
+		for _, check := range c.Checks {
+			stmts = append(stmts, prefix+" ADD CHECK ("+check+");")
+		}
+	}
+
+	for _, check := range t.Checks {
+		stmts = append(stmts, prefix+" ADD CHECK ("+check+");")
+	}
+	return stmts
+}
+
+// ident returns name as an SQL identifier, quoting it unless it is lower case
+// letters, digits and underscores.
+func ident(name string) string {
+	if identRegex.MatchString(name) {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// quote returns s as an SQL string literal.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func indirect(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
@@ -0,0 +1,76 @@
+package sqlcheck
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/go-playground/assert/v2"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Model struct {
+	ID        int64     `db:"id" validate:"required,gt=0"`
+	CreatedAt time.Time `db:"created_at" validate:"required"`
+}
+
+type Booking struct {
+	Model
+	Name     string    `db:"name" validate:"required,min=2,max=50"`
+	Code     string    `db:"code" validate:"len=6"`
+	Status   string    `db:"status" validate:"required,oneof=pending 'on hold' o'k"`
+	Guests   int       `db:"guests" validate:"gte=1,lte=10"`
+	Rating   float64   `db:"rating" validate:"omitempty,min=1.5,max=5"`
+	Priority int       `db:"priority" validate:"oneof=1 2 3"`
+	Note     *string   `db:"note" validate:"omitempty,max=200"`
+	Country  string    `db:"Country" validate:"ne=XX"`
+	StartsAt time.Time `db:"starts_at"`
+	EndsAt   time.Time `db:"ends_at" validate:"gtfield=StartsAt"`
+	Email    string    `db:"email" validate:"email"`
+	Tags     []string  `db:"tags" validate:"required"`
+	Ignored  string    `db:"-" validate:"required"`
+	Active   bool      `db:"active" validate:"required"`
+	Owner    *string   `db:"owner" validate:"required"`
+	Limit    float64   `db:"limit" validate:"max=Inf"`
+	Weight   float64   `db:"weight" validate:"oneof=1 NaN"`
+	Mask     int       `db:"mask" validate:"lte=0x10"`
+}
+
+func TestGenerate(t *testing.T) {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("db")
+	})
+
+	table := Generate(validate, reflect.TypeOf(Booking{}), Postgres)
+
+	Equal(t, table.Columns, []Column{
+		{Name: "id", Field: "ID", NotNull: true, Checks: []string{"id <> 0", "id > 0"}},
+		{Name: "created_at", Field: "CreatedAt", NotNull: true},
+		{Name: "name", Field: "Name", NotNull: true, Checks: []string{"name <> ''", "char_length(name) >= 2", "char_length(name) <= 50"}},
+		{Name: "code", Field: "Code", Checks: []string{"char_length(code) = 6"}},
+		{Name: "status", Field: "Status", NotNull: true, Checks: []string{"status <> ''", "status IN ('pending', 'on hold', 'ok')"}},
+		{Name: "guests", Field: "Guests", Checks: []string{"guests >= 1", "guests <= 10"}},
+		{Name: "rating", Field: "Rating", Checks: []string{"rating = 0 OR rating >= 1.5", "rating = 0 OR rating <= 5"}},
+		{Name: "priority", Field: "Priority", Checks: []string{"priority IN (1, 2, 3)"}},
+		{Name: "note", Field: "Note", Checks: []string{"note = '' OR char_length(note) <= 200"}},
+		{Name: "Country", Field: "Country", Checks: []string{`"Country" <> 'XX'`}},
+		{Name: "active", Field: "Active", NotNull: true, Checks: []string{"active"}},
+		{Name: "owner", Field: "Owner", NotNull: true},
+	})
+	Equal(t, table.Checks, []string{"ends_at > starts_at"})
+
+	Equal(t, table.Columns[2].Constraints(), "NOT NULL CHECK (name <> '') CHECK (char_length(name) >= 2) CHECK (char_length(name) <= 50)")
+	Equal(t, table.Constraints(), []string{"CHECK (ends_at > starts_at)"})
+
+	Equal(t, table.AlterTable("booking")[:3], []string{
+		"ALTER TABLE booking ALTER COLUMN id SET NOT NULL;",
+		"ALTER TABLE booking ADD CHECK (id <> 0);",
+		"ALTER TABLE booking ADD CHECK (id > 0);",
+	})
+	Equal(t, table.AlterTable("Booking")[len(table.AlterTable("Booking"))-1], `ALTER TABLE "Booking" ADD CHECK (ends_at > starts_at);`)
+
+	sqlite := Generate(validate, reflect.TypeOf(&Booking{}), SQLite)
+	Equal(t, sqlite.Columns[3].Checks, []string{"length(code) = 6"})
+}
@@ -0,0 +1,596 @@
+// Package zod generates TypeScript types and Zod schemas mirroring the
+// validation rules of Go structs, so frontends can share the backend's notion
+// of what is valid.
+//
+// Fields are named using the names returned by the function registered with
+// RegisterTagNameFunc, so register one returning the JSON name:
+//
+//	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+//		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+//		if name == "-" {
+//			return ""
+//		}
+//		return name
+//	})
+//
+//	err := zod.Generate(w, validate, reflect.TypeOf(User{}))
+//
+// Validations without a Zod equivalent, such as cross field validations, are
+// listed in a comment beside the field rather than enforced. Regex backed
+// validations use the same pattern as the validator, converted to JavaScript
+// syntax, so both sides agree on what matches. Note that JavaScript measures
+// string length in UTF-16 code units whereas the validator counts runes.
+package zod
+
+import (
+	"bufio"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	splitParamsRegex = regexp.MustCompile(`'[^']*'|\S+`)
+
+	timeType          = reflect.TypeOf(time.Time{})
+	durationType      = reflect.TypeOf(time.Duration(0))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+type kind uint8
+
+const (
+	kindUnknown kind = iota
+	kindString
+	kindNumber
+	kindBool
+	kindArray
+	kindRecord
+	kindObject
+)
+
+type generator struct {
+	v     *validator.Validate
+	names map[reflect.Type]string
+	taken map[string]bool
+	queue []reflect.Type
+}
+
+// value is the Zod expression and TypeScript type of a field or element.
+type value struct {
+	schema      string
+	ts          string
+	required    bool
+	unsupported []string
+}
+
+// Generate writes a TypeScript module to w declaring an interface and a Zod
+// schema, named after the Go type with a 'Schema' suffix, for each of types
+// and every struct type they reference.
+func Generate(w io.Writer, v *validator.Validate, types ...reflect.Type) error {
+	g := &generator{
+		v:     v,
+		names: make(map[reflect.Type]string),
+		taken: make(map[string]bool),
+	}
+
+	for _, typ := range types {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		if typ.Kind() != reflect.Struct {
+			return fmt.Errorf("zod: %s is not a struct", typ)
+		}
+		g.ref(typ)
+	}
+
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("// Code generated by validator/schema/zod. DO NOT EDIT.\n\n")
+	bw.WriteString("import { z } from \"zod\";\n")
+
+	for i := 0; i < len(g.queue); i++ {
+		g.writeType(bw, g.queue[i])
+	}
+	return bw.Flush()
+}
+
+// ref returns the TypeScript name of the struct typ, queueing it for generation.
+func (g *generator) ref(typ reflect.Type) string {
+	if name, ok := g.names[typ]; ok {
+		return name
+	}
+
+	base := identifier(typ.Name())
+	if len(base) == 0 {
+		base = "Anonymous"
+	}
+
+	name := base
+	for i := 2; g.taken[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+
+	g.names[typ] = name
+	g.taken[name] = true
+	g.queue = append(g.queue, typ)
+	return name
+}
+
+func (g *generator) writeType(w *bufio.Writer, typ reflect.Type) {
+	name := g.names[typ]
+
+	var props, fields strings.Builder
+
+	g.writeFields(&props, &fields, g.v.Describe(typ))
+
+	fmt.Fprintf(w, "\nexport interface %s {\n%s}\n", name, props.String())
+	fmt.Fprintf(w, "\nexport const %sSchema: z.ZodType<%s> = z.object({\n%s});\n", name, name, fields.String())
+}
+
+func (g *generator) writeFields(props, fields *strings.Builder, tr validator.TypeRules) {
+	for _, f := range tr.Fields {
+		if f.AltName == "-" || len(f.AltName) == 0 {
+			continue
+		}
+
+		if f.Anonymous && f.AltName == f.Name && indirect(f.Type).Kind() == reflect.Struct {
+			// embedded structs are flattened, as encoding/json does
+			g.writeFields(props, fields, g.v.Describe(f.Type))
+			continue
+		}
+
+		val := g.schema(f.Type, f.Rules)
+		key := property(f.AltName)
+
+		optional := "?"
+		schema := val.schema
+
+		if val.required {
+			optional = ""
+		} else {
+			schema += ".optional()"
+		}
+
+		fmt.Fprintf(props, "  %s%s: %s;\n", key, optional, val.ts)
+		fmt.Fprintf(fields, "  %s: %s,", key, schema)
+
+		if len(val.unsupported) > 0 {
+			fmt.Fprintf(fields, " // not enforced: %s", strings.Join(val.unsupported, ", "))
+		}
+		fields.WriteByte('\n')
+	}
+}
+
+// schema returns the schema of a value of typ validated by rules.
+func (g *generator) schema(typ reflect.Type, rules []validator.Rule) value {
+	nullable := false
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+		nullable = true
+	}
+
+	var dive *validator.Rule
+	for i := range rules {
+		if rules[i].Kind == validator.RuleDive {
+			dive = &rules[i]
+		}
+	}
+
+	k, schema, ts, unsupported := g.base(typ, dive)
+
+	if k == kindArray || k == kindRecord {
+		// nil slices and maps are encoded as null
+		nullable = true
+	}
+
+	var (
+		val       = value{unsupported: unsupported}
+		chain     strings.Builder
+		alts      []string
+		omitEmpty bool
+	)
+
+	for _, r := range rules {
+		switch r.Kind {
+		case validator.RuleOmitEmpty, validator.RuleOmitZero:
+			omitEmpty = true
+
+		case validator.RuleValidation:
+			if r.Tag == "required" {
+				val.required = true
+				nullable = false
+			}
+
+			if m, ok := refine(k, r); ok {
+				chain.WriteString(m)
+			} else {
+				val.unsupported = append(val.unsupported, tagString(r))
+			}
+
+		case validator.RuleOr:
+			var (
+				union []string
+				ok    = true
+			)
+
+			for _, alt := range r.Alternatives {
+				m, altOK := refine(k, alt)
+				if !altOK {
+					ok = false
+					break
+				}
+				union = append(union, schema+m)
+			}
+
+			if ok {
+				alts = append(alts, ".pipe(z.union(["+strings.Join(union, ", ")+"]))")
+			} else {
+				val.unsupported = append(val.unsupported, tagString(r))
+			}
+		}
+	}
+
+	val.schema = schema + chain.String() + strings.Join(alts, "")
+	val.ts = ts
+
+	if omitEmpty {
+		switch k {
+		case kindString:
+			val.schema += `.or(z.literal(""))`
+		case kindNumber:
+			val.schema += ".or(z.literal(0))"
+		case kindBool:
+			val.schema += ".or(z.literal(false))"
+		}
+	}
+
+	if nullable {
+		val.schema += ".nullable()"
+		val.ts += " | null"
+	}
+	return val
+}
+
+// base returns the unrefined schema and TypeScript type of typ, using the rules
+// of dive for the elements of slices, arrays and maps.
+func (g *generator) base(typ reflect.Type, dive *validator.Rule) (k kind, schema, ts string, unsupported []string) {
+	switch {
+	case typ == timeType:
+		return kindString, "z.string().datetime({ offset: true })", "string", nil
+
+	case typ == durationType:
+		return kindNumber, "z.number().int()", "number", nil
+
+	case typ.Implements(jsonMarshalerType) || reflect.PointerTo(typ).Implements(jsonMarshalerType):
+		return kindUnknown, "z.unknown()", "unknown", nil
+
+	case typ.Implements(textMarshalerType) || reflect.PointerTo(typ).Implements(textMarshalerType):
+		return kindString, "z.string()", "string", nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return kindString, "z.string()", "string", nil
+
+	case reflect.Bool:
+		return kindBool, "z.boolean()", "boolean", nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return kindNumber, "z.number().int()", "number", nil
+
+	case reflect.Float32, reflect.Float64:
+		return kindNumber, "z.number()", "number", nil
+
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			// encoded as a base64 string
+			return kindString, "z.string()", "string", nil
+		}
+
+		elem := g.elem(typ.Elem(), dive, false)
+		return kindArray, "z.array(" + elem.schema + ")", "Array<" + elem.ts + ">", elem.unsupported
+
+	case reflect.Map:
+		key := g.elem(typ.Key(), dive, true)
+		elem := g.elem(typ.Elem(), dive, false)
+
+		switch key.ts {
+		case "string":
+		case "number":
+			// object keys are always strings in JSON
+			key.schema = strings.Replace(key.schema, "z.number()", "z.coerce.number()", 1)
+		default:
+			key = value{schema: "z.string()", ts: "string"}
+		}
+		return kindRecord, "z.record(" + key.schema + ", " + elem.schema + ")", "Record<" + key.ts + ", " + elem.ts + ">", append(key.unsupported, elem.unsupported...)
+
+	case reflect.Struct:
+		name := g.ref(typ)
+		return kindObject, "z.lazy(() => " + name + "Schema)", name, nil
+	}
+	return kindUnknown, "z.unknown()", "unknown", nil
+}
+
+// elem returns the schema of a slice or array element, map value or, when key
+// is true, map key.
+func (g *generator) elem(typ reflect.Type, dive *validator.Rule, key bool) value {
+	var rules []validator.Rule
+
+	if dive != nil {
+		if key {
+			rules = dive.Keys
+		} else {
+			rules = dive.Elem
+		}
+	}
+
+	val := g.schema(typ, rules)
+	for i, u := range val.unsupported {
+		if key {
+			val.unsupported[i] = "keys " + u
+		} else {
+			val.unsupported[i] = "dive " + u
+		}
+	}
+	return val
+}
+
+// refine returns the Zod method chain enforcing r on a value of kind k.
+func refine(k kind, r validator.Rule) (string, bool) {
+	if r.Tag == "required" && k != kindString && k != kindNumber && k != kindBool {
+		// only needs the value to be present and not null
+		return "", true
+	}
+
+	if len(r.Pattern) > 0 && k == kindString {
+		return ".regex(" + regex(r.Pattern) + ")", true
+	}
+
+	switch k {
+	case kindString:
+		return refineString(r)
+
+	case kindNumber:
+		return refineNumber(r)
+
+	case kindBool:
+		if r.Tag == "required" {
+			return ".refine((v) => v)", true
+		}
+
+	case kindArray:
+		return refineLength(r, ".min(%d)", ".max(%d)", ".length(%d)")
+
+	case kindRecord:
+		return refineLength(r,
+			".refine((v) => Object.keys(v).length >= %d)",
+			".refine((v) => Object.keys(v).length <= %d)",
+			".refine((v) => Object.keys(v).length === %d)",
+		)
+
+	}
+	return "", false
+}
+
+func refineString(r validator.Rule) (string, bool) {
+	switch r.Tag {
+	case "required":
+		return ".min(1)", true
+
+	case "url", "http_url":
+		return ".url()", true
+
+	case "ip":
+		return ".ip()", true
+
+	case "ipv4", "ip4_addr":
+		return `.ip({ version: "v4" })`, true
+
+	case "ipv6", "ip6_addr":
+		return `.ip({ version: "v6" })`, true
+
+	case "contains":
+		return ".includes(" + quote(r.Param) + ")", true
+
+	case "excludes":
+		return ".refine((v) => !v.includes(" + quote(r.Param) + "))", true
+
+	case "startswith":
+		return ".startsWith(" + quote(r.Param) + ")", true
+
+	case "endswith":
+		return ".endsWith(" + quote(r.Param) + ")", true
+
+	case "lowercase":
+		return ".refine((v) => v === v.toLowerCase())", true
+
+	case "uppercase":
+		return ".refine((v) => v === v.toUpperCase())", true
+
+	case "eq":
+		return ".refine((v) => v === " + quote(r.Param) + ")", true
+
+	case "ne":
+		return ".refine((v) => v !== " + quote(r.Param) + ")", true
+
+	case "oneof":
+		vals := splitOneOf(r.Param)
+		for i := range vals {
+			vals[i] = quote(vals[i])
+		}
+		return ".refine((v) => [" + strings.Join(vals, ", ") + "].includes(v))", true
+	}
+	return refineLength(r, ".min(%d)", ".max(%d)", ".length(%d)")
+}
+
+func refineNumber(r validator.Rule) (string, bool) {
+	if r.Tag == "required" {
+		return ".refine((v) => v !== 0)", true
+	}
+
+	if r.Tag == "oneof" {
+		vals := splitOneOf(r.Param)
+		for _, val := range vals {
+			if _, err := strconv.ParseFloat(val, 64); err != nil {
+				return "", false
+			}
+		}
+		return ".refine((v) => [" + strings.Join(vals, ", ") + "].includes(v))", true
+	}
+
+	if _, err := strconv.ParseFloat(r.Param, 64); err != nil {
+		return "", false
+	}
+
+	switch r.Tag {
+	case "min", "gte":
+		return ".gte(" + r.Param + ")", true
+	case "max", "lte":
+		return ".lte(" + r.Param + ")", true
+	case "gt":
+		return ".gt(" + r.Param + ")", true
+	case "lt":
+		return ".lt(" + r.Param + ")", true
+	case "eq", "len":
+		return ".refine((v) => v === " + r.Param + ")", true
+	case "ne":
+		return ".refine((v) => v !== " + r.Param + ")", true
+	}
+	return "", false
+}
+
+// refineLength returns the length checks for the min/max/len and comparison
+// tags which, on strings, slices and maps, compare lengths.
+func refineLength(r validator.Rule, min, max, length string) (string, bool) {
+	switch r.Tag {
+	case "min", "max", "len", "eq", "gt", "gte", "lt", "lte":
+	default:
+		return "", false
+	}
+
+	n, err := strconv.Atoi(r.Param)
+	if err != nil {
+		return "", false
+	}
+
+	switch r.Tag {
+	case "min", "gte":
+		return fmt.Sprintf(min, n), true
+	case "max", "lte":
+		return fmt.Sprintf(max, n), true
+	case "gt":
+		return fmt.Sprintf(min, n+1), true
+	case "lt":
+		return fmt.Sprintf(max, n-1), true
+	}
+	return fmt.Sprintf(length, n), true
+}
+
+// regex returns the RE2 pattern as a JavaScript regular expression literal.
+//
+// '\x{...}' escapes are rewritten to '\u{...}' and a leading '(?i)' to the 'i'
+// flag, with the 'u' flag set when the pattern uses code point escapes or
+// Unicode classes.
+func regex(pattern string) string {
+	flags := ""
+
+	if idx := strings.Index(pattern, "(?i)"); idx != -1 {
+		pattern = pattern[:idx] + pattern[idx+4:]
+		flags += "i"
+	}
+
+	if strings.Contains(pattern, `\x{`) || strings.Contains(pattern, `\p{`) || strings.Contains(pattern, `\P{`) {
+		pattern = strings.ReplaceAll(pattern, `\x{`, `\u{`)
+		flags += "u"
+	}
+
+	var b strings.Builder
+	b.WriteByte('/')
+
+	escaped := false
+	for _, c := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '/':
+			b.WriteByte('\\')
+		case c < 0x20 || c == 0x7f:
+			fmt.Fprintf(&b, `\x%02x`, c)
+			continue
+		}
+		b.WriteRune(c)
+	}
+
+	b.WriteByte('/')
+	b.WriteString(flags)
+	return b.String()
+}
+
+// splitOneOf splits the param of a 'oneof' tag into its values as the validator does.
+func splitOneOf(param string) []string {
+	vals := splitParamsRegex.FindAllString(param, -1)
+	for i := range vals {
+		vals[i] = strings.ReplaceAll(vals[i], "'", "")
+	}
+	return vals
+}
+
+func tagString(r validator.Rule) string {
+	if r.Kind == validator.RuleOr {
+		tags := make([]string, len(r.Alternatives))
+		for i, alt := range r.Alternatives {
+			tags[i] = tagString(alt)
+		}
+		return strings.Join(tags, "|")
+	}
+
+	if r.HasParam {
+		return r.Tag + "=" + r.Param
+	}
+	return r.Tag
+}
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func indirect(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// identifier returns name with any characters not valid in a TypeScript identifier replaced.
+func identifier(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// property returns name as a TypeScript property name, quoting it when needed.
+func property(name string) string {
+	if identifier(name) == name && (name[0] < '0' || name[0] > '9') {
+		return name
+	}
+	return quote(name)
+}
@@ -0,0 +1,139 @@
+package zod
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/go-playground/assert/v2"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Base struct {
+	ID string `json:"id" validate:"required,uuid4"`
+}
+
+type Address struct {
+	Street string `json:"street" validate:"required,max=100"`
+	Zip    string `json:"zip" validate:"omitempty,len=5,numeric"`
+}
+
+type Category struct {
+	Name   string      `json:"name" validate:"required"`
+	Parent *Category   `json:"parent"`
+	Tags   []string    `json:"tags" validate:"omitempty,max=3,dive,alphanum"`
+	Attrs  map[int]int `json:"attrs" validate:"dive,keys,gt=0,endkeys,lte=10"`
+}
+
+type User struct {
+	Base
+	Name      string            `json:"name" validate:"required,min=2,max=50"`
+	Email     string            `json:"email" validate:"required,email"`
+	Age       int               `json:"age" validate:"gte=0,lte=130"`
+	Role      string            `json:"role" validate:"oneof=admin 'power user' guest"`
+	Contact   string            `json:"contact" validate:"omitempty,email|e164"`
+	Password  string            `json:"password" validate:"required"`
+	Confirm   string            `json:"confirm" validate:"eqfield=Password"`
+	Address   *Address          `json:"address" validate:"required"`
+	Addresses []Address         `json:"addresses" validate:"required,min=1,dive"`
+	Category  Category          `json:"category"`
+	Code      string            `json:"code" validate:"ulid"`
+	Created   time.Time         `json:"created"`
+	Labels    map[string]string `json:"labels"`
+	Active    bool              `json:"active"`
+	Secret    string            `json:"-"`
+}
+
+func TestGenerate(t *testing.T) {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return "-"
+		}
+		return name
+	})
+
+	var buf bytes.Buffer
+	Equal(t, Generate(&buf, validate, reflect.TypeOf(&User{})), nil)
+
+	out := buf.String()
+
+	Equal(t, strings.HasPrefix(out, "// Code generated by validator/schema/zod. DO NOT EDIT.\n\nimport { z } from \"zod\";\n"), true)
+
+	expected := []string{
+		`export interface User {
+  id: string;
+  name: string;
+  email: string;
+  age?: number;
+  role?: string;
+  contact?: string;
+  password: string;
+  confirm?: string;
+  address: Address;
+  addresses: Array<Address>;
+  category?: Category;
+  code?: string;
+  created?: string;
+  labels?: Record<string, string> | null;
+  active?: boolean;
+}`,
+		`  id: z.string().min(1).regex(/^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$/),`,
+		`  name: z.string().min(1).min(2).max(50),`,
+		`  age: z.number().int().gte(0).lte(130).optional(),`,
+		`  role: z.string().refine((v) => ["admin", "power user", "guest"].includes(v)).optional(),`,
+		`  contact: z.string().pipe(z.union([z.string().regex(/^(?:(?:(?:(?:[a-zA-Z]|\d|`,
+		`z.string().regex(/^\+?[1-9]\d{7,14}$/)])).or(z.literal("")).optional(),`,
+		`  confirm: z.string().optional(), // not enforced: eqfield=Password`,
+		`  address: z.lazy(() => AddressSchema),`,
+		`  addresses: z.array(z.lazy(() => AddressSchema)).min(1),`,
+		`  category: z.lazy(() => CategorySchema).optional(),`,
+		`  code: z.string().regex(/^[A-HJKMNP-TV-Z0-9]{26}$/i).optional(),`,
+		`  created: z.string().datetime({ offset: true }).optional(),`,
+		`  labels: z.record(z.string(), z.string()).nullable().optional(),`,
+		`  active: z.boolean().optional(),`,
+		`export const AddressSchema: z.ZodType<Address> = z.object({
+  street: z.string().min(1).max(100),
+  zip: z.string().length(5).regex(/^[-+]?[0-9]+(?:\.[0-9]+)?$/).or(z.literal("")).optional(),
+});`,
+		`export interface Category {
+  name: string;
+  parent?: Category | null;
+  tags?: Array<string> | null;
+  attrs?: Record<number, number> | null;
+}`,
+		`  parent: z.lazy(() => CategorySchema).nullable().optional(),`,
+		`  tags: z.array(z.string().regex(/^[a-zA-Z0-9]+$/)).max(3).nullable().optional(),`,
+		`  attrs: z.record(z.coerce.number().int().gt(0), z.number().int().lte(10)).nullable().optional(),`,
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(out, e) {
+			t.Errorf("expected output to contain:\n%s\n\ngot:\n%s", e, out)
+		}
+	}
+
+	Equal(t, strings.Contains(out, "Secret"), false)
+	Equal(t, strings.Count(out, "export interface Category {"), 1)
+	Equal(t, strings.Contains(out, `\u{00A0}-\u{D7FF}`), true)
+
+	NotEqual(t, Generate(&buf, validate, reflect.TypeOf("")), nil)
+}
+
+func TestRegex(t *testing.T) {
+	Equal(t, regex(`^a/b$`), `/^a\/b$/`)
+	Equal(t, regex(`^a\/b$`), `/^a\/b$/`)
+	Equal(t, regex(`^(?i)[a-z]+$`), `/^[a-z]+$/i`)
+	Equal(t, regex(`^[\p{L}]+$`), `/^[\p{L}]+$/u`)
+	Equal(t, regex(`^[\x{00A0}]$`), `/^[\u{00A0}]$/u`)
+	Equal(t, regex("^[\x00-\x7F]*$"), `/^[\x00-\x7f]*$/`)
+}
+
+func TestSplitOneOf(t *testing.T) {
+	Equal(t, splitOneOf("a 'b c' d"), []string{"a", "b c", "d"})
+	Equal(t, len(splitOneOf("")), 0)
+}
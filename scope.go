@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+)
+
+// scope restricts which fields, elements and map values are traversed during
+// validation, a nil scope imposes no restriction.
+type scope interface {
+	// field returns the scope of the struct field and whether it is to be validated.
+	field(name, altName string) (scope, bool)
+
+	// index returns the scope of a slice or array element and whether it is to be validated.
+	index(i int) (scope, bool)
+
+	// key returns the scope of a map value and whether it is to be validated.
+	key(k string) (scope, bool)
+}
+
+// structScopedCtx validates the fields of s within sc, see StructPresent and StructMask.
+func (v *Validate) structScopedCtx(ctx context.Context, s interface{}, sc scope) (err error) {
+	val := reflect.ValueOf(s)
+	top := val
+
+	if val.Kind() == reflect.Ptr && !val.IsNil() {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct || val.Type().ConvertibleTo(timeType) {
+		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	// good to validate
+	vd := v.pool.Get().(*validate)
+	vd.top = top
+	vd.isPartial = false
+	vd.scope = sc
+
+	if (v.hasModifiers || v.hasDefaults) && val.CanAddr() {
+		vd.modifyStruct(ctx, val)
+	}
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	err = vd.takeErrors()
+
+	vd.scope = nil
+	v.pool.Put(vd)
+
+	return
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"reflect"
+	"strings"
 )
 
 // StructLevelFunc accepts all values needed for struct level validation
@@ -113,33 +114,23 @@ func (v *validate) ReportError(field interface{}, fieldName, structFieldName, ta
 		structFieldName = fieldName
 	}
 
-	v.str1 = string(append(v.ns, fieldName...))
+	v.reserveErrBuf()
+	start := len(v.errBuf)
+	v.errBuf = append(append(v.errBuf, v.ns...), fieldName...)
+	v.str1 = v.errString(start)
 
 	if v.v.hasTagNameFunc || fieldName != structFieldName {
-		v.str2 = string(append(v.actualNs, structFieldName...))
+		start = len(v.errBuf)
+		v.errBuf = append(append(v.errBuf, v.actualNs...), structFieldName...)
+		v.str2 = v.errString(start)
 	} else {
 		v.str2 = v.str1
 	}
 
-	if kind == reflect.Invalid {
-		v.errs = append(v.errs,
-			&fieldError{
-				v:              v.v,
-				tag:            tag,
-				actualTag:      tag,
-				ns:             v.str1,
-				structNs:       v.str2,
-				fieldLen:       uint8(len(fieldName)),
-				structfieldLen: uint8(len(structFieldName)),
-				param:          param,
-				kind:           kind,
-			},
-		)
-		return
-	}
+	seq, labels, sensitive := v.reportedField(structFieldName)
 
-	v.errs = append(v.errs,
-		&fieldError{
+	if kind == reflect.Invalid {
+		v.appendError(fieldError{
 			v:              v.v,
 			tag:            tag,
 			actualTag:      tag,
@@ -147,12 +138,31 @@ func (v *validate) ReportError(field interface{}, fieldName, structFieldName, ta
 			structNs:       v.str2,
 			fieldLen:       uint8(len(fieldName)),
 			structfieldLen: uint8(len(structFieldName)),
-			value:          getValue(fv),
 			param:          param,
+			seq:            seq,
+			labels:         labels,
+			sensitive:      sensitive,
 			kind:           kind,
-			typ:            fv.Type(),
-		},
-	)
+		})
+		return
+	}
+
+	v.appendError(fieldError{
+		v:              v.v,
+		tag:            tag,
+		actualTag:      tag,
+		ns:             v.str1,
+		structNs:       v.str2,
+		fieldLen:       uint8(len(fieldName)),
+		structfieldLen: uint8(len(structFieldName)),
+		value:          getValue(fv),
+		param:          param,
+		seq:            seq,
+		labels:         labels,
+		sensitive:      sensitive,
+		kind:           kind,
+		typ:            fv.Type(),
+	})
 }
 
 // ReportValidationErrors reports ValidationErrors obtained from running validations within the Struct Level validation.
@@ -165,7 +175,30 @@ func (v *validate) ReportValidationErrors(relativeNamespace, relativeStructNames
 		err = errs[i].(*fieldError)
 		err.ns = string(append(append(v.ns, relativeNamespace...), err.ns...))
 		err.structNs = string(append(append(v.actualNs, relativeStructNamespace...), err.structNs...))
+		err.seq = v.seq
 
 		v.errs = append(v.errs, err)
 	}
 }
+
+// reportedField returns the seq, labels and whether it is tagged sensitive:"true" of
+// the field of the current struct named by the struct field name passed to
+// ReportError eg. 'Names[0]', or the seq of the last field visited when the
+// struct has no such field.
+func (v *validate) reportedField(structFieldName string) (uint64, map[string]string, bool) {
+	if v.slStruct == nil {
+		return v.seq, nil, false
+	}
+
+	name := structFieldName
+	if i := strings.IndexAny(name, ".["); i > 0 {
+		name = name[:i]
+	}
+
+	for i, f := range v.slStruct.fields {
+		if f.name == name && i < len(v.slSeqs) {
+			return v.slSeqs[i], f.labels, f.cTags != nil && f.cTags.sensitive
+		}
+	}
+	return v.seq, nil, false
+}
@@ -1,11 +1,333 @@
 package validator
 
-import ut "github.com/go-playground/universal-translator"
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+)
+
+const labelTagPrefix = "label_"
 
 // TranslationFunc is the function type used to register or override
 // custom translations
 type TranslationFunc func(ut ut.Translator, fe FieldError) string
 
+// TranslationFuncCtx is the function type used to register translations
+// needing the context errors are translated with eg. for tenant-specific
+// wording, see RegisterTranslationCtx.
+type TranslationFuncCtx func(ctx context.Context, ut ut.Translator, fe FieldError) string
+
+// LocaleResolver returns the locales preferred for ctx eg. "pt_BR" or "pt-BR",
+// most preferred first.
+type LocaleResolver func(ctx context.Context) []string
+
+type localesKey struct{}
+
+// ContextWithLocales returns a copy of ctx carrying the preferred locales, most
+// preferred first, errors are translated in by TranslateCtx.
+func ContextWithLocales(ctx context.Context, locales ...string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+// LocalesFromContext returns the locales set using ContextWithLocales, it is
+// the default LocaleResolver.
+func LocalesFromContext(ctx context.Context) []string {
+	locales, _ := ctx.Value(localesKey{}).([]string)
+	return locales
+}
+
 // RegisterTranslationsFunc allows for registering of translations
 // for a 'ut.Translator' for use within the 'TranslationFunc'
 type RegisterTranslationsFunc func(ut ut.Translator) error
+
+// RegisterTranslationFallback registers the translators used, in order, to
+// translate errors whose tag has no translation registered for trans, in place
+// of the fallback derived from its locale, eg. for pt_BR falling back to es:
+//
+//	validate.RegisterTranslationFallback(ptBR, es)
+//
+// Without one the translator of the parent locale is used, eg. pt for pt_BR,
+// and for a language the fallback translator of WithTranslators. Fallbacks are
+// followed transitively, so pt_BR falls back to pt and then en with en as the
+// fallback translator, and es to its own fallbacks in the example above.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterTranslationFallback(trans ut.Translator, fallbacks ...ut.Translator) {
+	if v.transFallbacks == nil {
+		v.transFallbacks = make(map[ut.Translator][]ut.Translator)
+	}
+	v.transFallbacks[trans] = fallbacks
+}
+
+// MissingTranslations returns the sorted tags of the registered validations and
+// aliases which have no translation registered for trans, not counting those
+// translated by its fallbacks.
+func (v *Validate) MissingTranslations(trans ut.Translator) []string {
+	m := v.transTagFunc[v.translator(trans)]
+
+	var missing []string
+
+	for tag := range v.validations {
+		if _, ok := m[tag]; !ok {
+			missing = append(missing, tag)
+		}
+	}
+
+	for alias := range v.aliases {
+		if _, ok := m[alias]; !ok {
+			missing = append(missing, alias)
+		}
+	}
+
+	slices.Sort(missing)
+	return missing
+}
+
+// TranslatorCtx returns the translator for the first of the locales resolved
+// from ctx, see WithLocaleResolver, with a translator registered, trying each
+// locale's language eg. pt for pt_BR after it. Translators are those
+// registered using WithTranslators, or else those translations were registered
+// for. When none match the fallback translator of WithTranslators is returned,
+// nil without it.
+func (v *Validate) TranslatorCtx(ctx context.Context) ut.Translator {
+	resolve := v.localeResolver
+	if resolve == nil {
+		resolve = LocalesFromContext
+	}
+
+	if trans, ok := FindTranslator(resolve(ctx), v.registeredTranslator); ok {
+		return trans
+	}
+
+	if v.uni != nil {
+		return v.uni.GetFallback()
+	}
+	return nil
+}
+
+// FindTranslator returns the translator find returns for the first of locales
+// eg. "pt_BR" or "pt-BR" it finds one for, trying each locale's language eg. pt
+// for pt_BR after it, as locales are named by ut.UniversalTranslator.
+func FindTranslator(locales []string, find func(locale string) (ut.Translator, bool)) (ut.Translator, bool) {
+	for _, locale := range locales {
+		locale = strings.ReplaceAll(locale, "-", "_")
+
+		if trans, ok := find(locale); ok {
+			return trans, true
+		}
+
+		if i := strings.IndexByte(locale, '_'); i > 0 {
+			if trans, ok := find(locale[:i]); ok {
+				return trans, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// registeredTranslator returns the translator registered for locale.
+func (v *Validate) registeredTranslator(locale string) (ut.Translator, bool) {
+	if v.uni != nil {
+		return v.uni.GetTranslator(locale)
+	}
+
+	trans, ok := v.transLocales[locale]
+	return trans, ok
+}
+
+// translator returns the translator translations were registered with for the
+// locale of trans, so translators of the same locale obtained from another
+// UniversalTranslator are translated alike, or trans when there is none.
+func (v *Validate) translator(trans ut.Translator) ut.Translator {
+	if trans == nil {
+		return nil
+	}
+
+	if _, ok := v.transTagFunc[trans]; ok {
+		return trans
+	}
+
+	if t, ok := v.transLocales[trans.Locale()]; ok {
+		return t
+	}
+	return trans
+}
+
+// translation returns the translation function for tag, or actualTag when it
+// has none, registered for trans or else the first translator along its chain
+// of fallbacks having one, along with the translator it was registered for.
+func (v *Validate) translation(trans ut.Translator, tag, actualTag string) (ut.Translator, TranslationFuncCtx, bool) {
+	var seen []ut.Translator
+	return v.translationChain(trans, tag, actualTag, &seen)
+}
+
+// translationChain does the same as translation, skipping the translators
+// already seen so that fallbacks registered in a cycle end.
+func (v *Validate) translationChain(trans ut.Translator, tag, actualTag string, seen *[]ut.Translator) (ut.Translator, TranslationFuncCtx, bool) {
+	trans = v.translator(trans)
+	if trans == nil || slices.Contains(*seen, trans) {
+		return nil, nil, false
+	}
+	*seen = append(*seen, trans)
+
+	if fn, ok := v.translationFor(trans, tag, actualTag); ok {
+		return trans, fn, true
+	}
+
+	for _, fallback := range v.fallbacks(trans) {
+		if t, fn, ok := v.translationChain(fallback, tag, actualTag, seen); ok {
+			return t, fn, true
+		}
+	}
+	return nil, nil, false
+}
+
+// fallbacks returns the translators tried when trans has no translation, those
+// registered using RegisterTranslationFallback or else the translator of its
+// parent locale eg. pt for pt_BR, or for a language the fallback translator of
+// WithTranslators.
+func (v *Validate) fallbacks(trans ut.Translator) []ut.Translator {
+	if fallbacks, ok := v.transFallbacks[trans]; ok {
+		return fallbacks
+	}
+
+	locale := trans.Locale()
+	for i := strings.LastIndexByte(locale, '_'); i > 0; i = strings.LastIndexByte(locale, '_') {
+		locale = locale[:i]
+
+		if parent, ok := v.registeredTranslator(locale); ok {
+			return []ut.Translator{parent}
+		}
+	}
+
+	if v.uni != nil {
+		return []ut.Translator{v.uni.GetFallback()}
+	}
+	return nil
+}
+
+func (v *Validate) translationFor(trans ut.Translator, tag, actualTag string) (TranslationFuncCtx, bool) {
+	m, ok := v.transTagFunc[trans]
+	if !ok {
+		return nil, false
+	}
+
+	fn, ok := m[tag]
+	if !ok {
+		fn, ok = m[actualTag]
+	}
+	return fn, ok
+}
+
+// RegisterFieldLabel registers the label of the field, named as in the struct,
+// of the struct type of structType used in place of the field's name in
+// translations for the locale of trans, see FieldLabel. Labels may also
+// be given using struct tags naming the locale eg. label_de:"Vorname", which
+// registered labels take precedence over.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterFieldLabel(trans ut.Translator, structType interface{}, field, label string) {
+	typ := reflect.TypeOf(structType)
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if v.labels == nil {
+		v.labels = make(map[reflect.Type]map[string]map[string]string)
+	}
+
+	fields, ok := v.labels[typ]
+	if !ok {
+		fields = make(map[string]map[string]string)
+		v.labels[typ] = fields
+	}
+
+	labels, ok := fields[field]
+	if !ok {
+		labels = make(map[string]string)
+		fields[field] = labels
+	}
+
+	labels[trans.Locale()] = label
+	v.clearCaches()
+}
+
+// fieldLabels returns the labels of the field fld of the struct type typ, from
+// its label tags and those registered, nil when it has none.
+func (v *Validate) fieldLabels(typ reflect.Type, fld reflect.StructField) map[string]string {
+	labels := labelTags(fld.Tag)
+
+	if registered, ok := v.labels[typ][fld.Name]; ok {
+		if labels == nil {
+			labels = make(map[string]string, len(registered))
+		}
+
+		for locale, label := range registered {
+			labels[locale] = label
+		}
+	}
+	return labels
+}
+
+// labelTags returns the labels given by the struct tags naming a locale eg.
+// label_de:"Vorname", nil when there are none.
+func labelTags(tag reflect.StructTag) map[string]string {
+	if !strings.Contains(string(tag), labelTagPrefix) {
+		return nil
+	}
+
+	var labels map[string]string
+
+	// parses the conventional key:"value" format, as reflect.StructTag does
+	for s := string(tag); len(s) > 0; {
+		s = strings.TrimLeft(s, " ")
+
+		i := 0
+		for i < len(s) && s[i] > ' ' && s[i] != ':' && s[i] != '"' && s[i] != 0x7f {
+			i++
+		}
+
+		if i == 0 || i+1 >= len(s) || s[i] != ':' || s[i+1] != '"' {
+			break
+		}
+
+		key := s[:i]
+		s = s[i+1:]
+
+		// scan the quoted value
+		i = 1
+		for i < len(s) && s[i] != '"' {
+			if s[i] == '\\' {
+				i++
+			}
+			i++
+		}
+
+		if i >= len(s) {
+			break
+		}
+
+		quoted := s[:i+1]
+		s = s[i+1:]
+
+		locale, ok := strings.CutPrefix(key, labelTagPrefix)
+		if !ok || len(locale) == 0 {
+			continue
+		}
+
+		label, err := strconv.Unquote(quoted)
+		if err != nil {
+			break
+		}
+
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[locale] = label
+	}
+	return labels
+}
@@ -4,12 +4,11 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -124,11 +123,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -149,7 +144,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -161,10 +156,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -216,7 +211,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						return nil
 					}
 
-					t, err = ut.T("min-duration", fe.Field(), fe.Param())
+					t, err = ut.T("min-duration", validator.FieldLabel(fe, ut), fe.Param())
 					return
 				}
 
@@ -230,15 +225,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				}
 
 				if fe.Type() == reflect.TypeOf(time.Duration(0)) {
-					t, err = ut.T("min-duration", fe.Field(), fe.Param())
+					t, err = ut.T("min-duration", validator.FieldLabel(fe, ut), fe.Param())
 					goto END
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err = strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err = msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -254,7 +245,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -266,10 +257,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -322,7 +313,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						return nil
 					}
 
-					t, err = ut.T("max-duration", fe.Field(), fe.Param())
+					t, err = ut.T("max-duration", validator.FieldLabel(fe, ut), fe.Param())
 					return
 				}
 
@@ -336,15 +327,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				}
 
 				if fe.Type() == reflect.TypeOf(time.Duration(0)) {
-					t, err = ut.T("max-duration", fe.Field(), fe.Param())
+					t, err = ut.T("max-duration", validator.FieldLabel(fe, ut), fe.Param())
 					goto END
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err = strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err = msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -360,7 +347,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -372,10 +359,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -440,15 +427,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 
 				// Special handling for time.Time
 				if fe.Type() == reflect.TypeOf(time.Time{}) {
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 					goto END
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err = strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err = msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -464,7 +447,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -476,13 +459,13 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 
 				default:
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -537,15 +520,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 
 				// Special handling for time.Time
 				if fe.Type() == reflect.TypeOf(time.Time{}) {
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 					goto END
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err = strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err = msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -561,7 +540,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -573,13 +552,13 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 
 				default:
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -596,7 +575,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} مساويا ل {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -610,7 +589,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} مساويا ل {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -624,7 +603,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} لا يمكن أن يساوي {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -638,7 +617,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} أكبر من {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -652,7 +631,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} أكبر من أو يساوي {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -666,7 +645,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} أصغر من {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -680,7 +659,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} أصغر من أو يساوي {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -694,7 +673,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} لا يمكن أن يساوي {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -746,15 +725,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 
 				// Special handling for time.Time
 				if fe.Type() == reflect.TypeOf(time.Time{}) {
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 					goto END
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err = strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err = msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -770,7 +745,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -782,13 +757,13 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 
 				default:
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -880,7 +855,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يحتوي {0} على النص '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -894,7 +869,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يحتوي {0} على حرف واحد على الأقل من الأحرف التالية '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -908,7 +883,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "لا يمكن أن يحتوي {0} على النص '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -922,7 +897,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "لا يمكن أن يحتوي {0} على أي من الأحرف التالية '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -936,7 +911,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "لا يمكن أن يحتوي {0} على التالي '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1125,7 +1100,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} واحدا من [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1158,7 +1133,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "لا يتطابق {0} مع تنسيق {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1172,7 +1147,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "لا يتطابق {0} مع تنسيق الرمز البريدي للبلد {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1186,7 +1161,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "لا يتطابق {0} مع تنسيق الرمز البريدي للبلد في حقل {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1258,15 +1233,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 
 				// Special handling for time.Time
 				if fe.Type() == reflect.TypeOf(time.Time{}) {
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 					goto END
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err = strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err = msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -1282,7 +1253,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -1294,13 +1265,13 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 
 				default:
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -1317,7 +1288,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} أكبر من {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1331,7 +1302,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} أكبر من أو يساوي {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1345,7 +1316,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} أصغر من {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1359,7 +1330,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} أصغر من أو يساوي {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1393,6 +1364,396 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "يجب أن يكون {0} إصدار دلالي صالح",
 			override:    false,
 		},
+		{
+			tag:         "skip_unless",
+			translation: "حقل {0} مطلوب",
+			override:    false,
+		},
+		{
+			tag:         "eq_ignore_case",
+			translation: "يجب أن يكون {0} مساويا ل {1} بغض النظر عن حالة الأحرف",
+			override:    false,
+		},
+		{
+			tag:         "ne_ignore_case",
+			translation: "يجب ألا يساوي {0} {1} بغض النظر عن حالة الأحرف",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "يجب أن يحتوي {0} على قيمة الحقل {1}",
+			override:    false,
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "لا يمكن أن يحتوي {0} على قيمة الحقل {1}",
+			override:    false,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "يمكن أن يحتوي {0} على أحرف أبجدية ومسافات فقط",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "يمكن أن يحتوي {0} على أحرف أبجدية رقمية ومسافات فقط",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "يمكن أن يحتوي {0} على أحرف يونيكود فقط",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "يمكن أن يحتوي {0} على أحرف وأرقام يونيكود فقط",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "يجب أن يكون {0} لون CMYK صالح",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "يجب أن يكون {0} سلسلة Base32 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "يجب أن يكون {0} سلسلة Base64 URL صالحة",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "يجب أن يكون {0} سلسلة Base64 URL صالحة بدون حشو",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "يجب أن يحتوي {0} على الحرف '{1}'",
+			override:    false,
+		},
+		{
+			tag:         "startswith",
+			translation: "يجب أن يبدأ {0} ب '{1}'",
+			override:    false,
+		},
+		{
+			tag:         "endswith",
+			translation: "يجب أن ينتهي {0} ب '{1}'",
+			override:    false,
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "يجب ألا يبدأ {0} ب '{1}'",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "يجب ألا ينتهي {0} ب '{1}'",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "يجب أن يكون {0} URN صالح وفق RFC 2141",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "يجب أن يكون {0} URN صالح وفق RFC 8141",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "يجب أن يكون {0} UUID صالح وفق RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "يجب أن يكون {0} UUID صالح وفق RFC 4122 من النسخة 3",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "يجب أن يكون {0} UUID صالح وفق RFC 4122 من النسخة 4",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "يجب أن يكون {0} UUID صالح وفق RFC 4122 من النسخة 5",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "يجب أن يكون {0} تجزئة MD4 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "يجب أن يكون {0} تجزئة SHA384 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "يجب أن يكون {0} تجزئة SHA512 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "يجب أن يكون {0} تجزئة RIPEMD-128 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "يجب أن يكون {0} تجزئة RIPEMD-160 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "يجب أن يكون {0} تجزئة TIGER128 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "يجب أن يكون {0} تجزئة TIGER160 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "يجب أن يكون {0} تجزئة TIGER192 صالحة",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "يجب أن يكون {0} عنوان Bitcoin صالح",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "يجب أن يكون {0} عنوان Bitcoin Bech32 صالح",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "يجب أن يكون {0} عنوان Ethereum صالح",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "يجب أن يكون {0} عنوان Ethereum صالح مع مجموع اختباري",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "يجب أن يكون {0} اسم مضيف صالح",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "يجب أن يكون {0} اسم مضيف صالح وفق RFC 1123",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "يجب أن يكون {0} اسم مضيف ومنفذ صالحين",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "يجب أن يكون {0} رقم منفذ صالح",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "يجب أن يكون {0} تسمية DNS صالحة وفق RFC 1035",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "يجب أن يكون {0} رابط HTTP صالح",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "يجب أن يكون {0} رابط HTTPS صالح",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "يجب أن يكون {0} مصدر (origin) صالح",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "يجب أن يكون {0} مرمزا بترميز URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "يجب أن يحتوي {0} على وسوم HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "يجب أن يكون {0} مرمزا بترميز HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "يجب أن يكون {0} مقبس نطاق يونكس موجود",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "يجب أن يكون {0} مجلدا موجودا",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "يجب أن يكون {0} مسار مجلد صالح",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "يجب أن يكون {0} ملفا موجودا",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "يجب أن يكون {0} مسار ملف صالح",
+			override:    false,
+		},
+		{
+			tag:         "noneof",
+			translation: "يجب ألا يكون {0} واحدا من [{1}]",
+			override:    false,
+		},
+		{
+			tag:         "oneofci",
+			translation: "يجب أن يكون {0} واحدا من [{1}] بغض النظر عن حالة الأحرف",
+			override:    false,
+		},
+		{
+			tag:         "noneofci",
+			translation: "يجب ألا يكون {0} واحدا من [{1}] بغض النظر عن حالة الأحرف",
+			override:    false,
+		},
+		{
+			tag:         "timezone",
+			translation: "يجب أن يكون {0} منطقة زمنية صالحة",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "يجب أن يكون {0} رمز دولة صالح",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "يجب أن يكون {0} رمز دولة صالح في الاتحاد الأوروبي",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "يجب أن يكون {0} رمز دولة ISO 3166-1 alpha-2 صالح",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "يجب أن يكون {0} رمز دولة ISO 3166-1 alpha-2 صالح في الاتحاد الأوروبي",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "يجب أن يكون {0} رمز دولة ISO 3166-1 alpha-3 صالح",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "يجب أن يكون {0} رمز دولة ISO 3166-1 alpha-3 صالح في الاتحاد الأوروبي",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "يجب أن يكون {0} رمز دولة ISO 3166-1 رقمي صالح",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "يجب أن يكون {0} رمز دولة ISO 3166-1 رقمي صالح في الاتحاد الأوروبي",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "يجب أن يكون {0} رمز منطقة ISO 3166-2 صالح",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "يجب أن يكون {0} رمز عملة ISO 4217 صالح",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "يجب أن يكون {0} رمز عملة ISO 4217 رقمي صالح",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "يجب أن يكون {0} وسم لغة BCP 47 صالح",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "يجب أن يكون {0} وسم لغة BCP 47 صالح",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "يجب أن يكون {0} رمز BIC صالح",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "يجب أن يكون {0} رمز BIC صالح وفق ISO 9362:2014",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "يجب أن يكون {0} رقم بطاقة ائتمان صالح",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "يجب أن يكون {0} مجموع Luhn اختباري صالح",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "يجب أن يكون {0} رقم EIN صالح",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "يجب أن يكون {0} معرف MongoDB ObjectID صالح",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "يجب أن يكون {0} سلسلة اتصال MongoDB صالحة",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "يجب أن يكون {0} معرف SpiceDB صالح",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "يجب أن يكون {0} كائنا صالحا",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1418,7 +1779,7 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		return fe.(error).Error()
 	}
@@ -0,0 +1,432 @@
+// Package catalog registers translations from message catalog files, so the
+// wording of error messages can be edited, and locales added, without writing
+// Go code.
+//
+// Catalogs map a validation tag to its message, {0} being replaced by the
+// field's name and {1} by the tag's parameter. Messages may differ by the kind
+// of field, by suffixing the tag with '-string', '-number', '-items' for
+// slices, arrays and maps or '-datetime' for time.Time, and may give the forms
+// of the parameter for each of the locale's cardinal plural rules, eg. in JSON:
+//
+//	{
+//		"required": "{0} is a required field",
+//		"min-string": {
+//			"text": "{0} must be at least {1} in length",
+//			"plural": {"one": "{0} character", "other": "{0} characters"}
+//		},
+//		"min-number": "{0} must be {1} or greater"
+//	}
+//
+// or in YAML:
+//
+//	required: "{0} is a required field"
+//	min-string:
+//	  text: "{0} must be at least {1} in length"
+//	  plural:
+//	    one: "{0} character"
+//	    other: "{0} characters"
+//
+// In gettext .po files the tag is the msgctxt of the message and of the plural
+// forms of its parameter, msgstr[n] being the form for the nth of the locale's
+// cardinal plural rules. Fuzzy and untranslated entries are skipped:
+//
+//	msgctxt "min-string"
+//	msgid "{0} must be at least {1} in length"
+//	msgstr "{0} doit faire au moins {1}"
+//
+//	msgctxt "min-string"
+//	msgid "{0} character"
+//	msgid_plural "{0} characters"
+//	msgstr[0] "{0} caractère"
+//	msgstr[1] "{0} caractères"
+//
+// Catalogs are registered over any existing translations for their tags, so
+// can be used to change the wording of the translations packages:
+//
+//	//go:embed locales
+//	var locales embed.FS
+//
+//	err := catalog.RegisterFS(validate, uni, locales, "locales/*.json")
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/locales"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// Format is the format of a catalog file.
+type Format uint8
+
+// Formats
+const (
+	JSON Format = iota
+	YAML
+	PO
+)
+
+// Kinds of field a message may be specific to.
+const (
+	KindString   = "string"
+	KindNumber   = "number"
+	KindItems    = "items"
+	KindDatetime = "datetime"
+)
+
+var kinds = []string{KindString, KindNumber, KindItems, KindDatetime}
+
+var pluralRules = map[string]locales.PluralRule{
+	"zero":  locales.PluralRuleZero,
+	"one":   locales.PluralRuleOne,
+	"two":   locales.PluralRuleTwo,
+	"few":   locales.PluralRuleFew,
+	"many":  locales.PluralRuleMany,
+	"other": locales.PluralRuleOther,
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// Message is the translation of a validation tag.
+type Message struct {
+	Tag string
+
+	// Kind is the kind of field the message is for, empty for any kind without
+	// a message of its own.
+	Kind string
+
+	// Text is the message, {0} being replaced by the field's name and {1} by the
+	// tag's parameter.
+	Text string
+
+	// Plural are the forms of the parameter, {0} being replaced by it, keyed by
+	// cardinal plural rule name eg. 'one', 'other', or by the index of the rule
+	// within the locale's cardinal plural rules eg. '0', '1'.
+	Plural map[string]string
+}
+
+// key returns the key of the message's translation.
+func (m Message) key() string {
+	if len(m.Kind) == 0 {
+		return m.Tag
+	}
+	return m.Tag + "-" + m.Kind
+}
+
+// Catalog is a locale's messages, ordered by tag and kind.
+type Catalog struct {
+	Messages []Message
+}
+
+// Parse parses the catalog data in the given format.
+func Parse(data []byte, format Format) (Catalog, error) {
+	switch format {
+	case JSON:
+		var m map[string]any
+		if err := json.Unmarshal(data, &m); err != nil {
+			return Catalog{}, err
+		}
+		return fromMap(m)
+
+	case YAML:
+		m, err := parseYAML(data)
+		if err != nil {
+			return Catalog{}, err
+		}
+		return fromMap(m)
+
+	case PO:
+		return parsePO(data)
+	}
+	return Catalog{}, fmt.Errorf("catalog: unknown format %d", format)
+}
+
+// Load reads the catalog in the file name of fsys, in the format given by its
+// extension: .json, .yaml or .yml, or .po.
+func Load(fsys fs.FS, name string) (Catalog, error) {
+	var format Format
+
+	switch ext := path.Ext(name); ext {
+	case ".json":
+		format = JSON
+	case ".yaml", ".yml":
+		format = YAML
+	case ".po":
+		format = PO
+	default:
+		return Catalog{}, fmt.Errorf("catalog: unknown format of %s", name)
+	}
+
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	c, err := Parse(data, format)
+	if err != nil {
+		return Catalog{}, fmt.Errorf("catalog: %s: %w", name, err)
+	}
+	return c, nil
+}
+
+// RegisterFS registers the catalogs in the files of fsys matching pattern, see
+// fs.Glob, each for the locale named by the file's name without its extension
+// eg. pt_BR.json, using uni's translator for the locale.
+func RegisterFS(v *validator.Validate, uni *ut.UniversalTranslator, fsys fs.FS, pattern string) error {
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		locale := strings.TrimSuffix(path.Base(name), path.Ext(name))
+
+		trans, found := uni.GetTranslator(locale)
+		if !found {
+			return fmt.Errorf("catalog: %s: no translator for locale %s", name, locale)
+		}
+
+		c, err := Load(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		if err = Register(v, trans, c); err != nil {
+			return fmt.Errorf("catalog: %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Register registers the translations of the catalog's messages for trans,
+// replacing any registered for their tags.
+func Register(v *validator.Validate, trans ut.Translator, c Catalog) error {
+	c.Messages = slices.Clone(c.Messages)
+	c.sort()
+
+	for i := 0; i < len(c.Messages); {
+		tag := c.Messages[i].Tag
+
+		j := i + 1
+		for j < len(c.Messages) && c.Messages[j].Tag == tag {
+			j++
+		}
+
+		msgs := c.Messages[i:j]
+		i = j
+
+		err := v.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+			return addMessages(ut, msgs)
+		}, translate(msgs))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addMessages adds the translations of the messages of a tag to trans.
+func addMessages(trans ut.Translator, msgs []Message) error {
+	for _, m := range msgs {
+		key := m.key()
+
+		if err := trans.Add(key, m.Text, true); err != nil {
+			return err
+		}
+
+		rules := trans.PluralsCardinal()
+
+		for name, text := range m.Plural {
+			rule, ok := pluralRules[name]
+			if !ok {
+				i, err := strconv.Atoi(name)
+				if err != nil || i < 0 || i >= len(rules) {
+					return fmt.Errorf("unknown plural rule %s for %s in locale %s", name, key, trans.Locale())
+				}
+				rule = rules[i]
+			}
+
+			if err := trans.AddCardinal(key+"-plural", text, rule, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// translate returns the TranslationFunc choosing between the messages of a tag
+// by the kind of the field.
+func translate(msgs []Message) validator.TranslationFunc {
+	return func(ut ut.Translator, fe validator.FieldError) string {
+		kind := fieldKind(fe)
+
+		i := slices.IndexFunc(msgs, func(m Message) bool { return m.Kind == kind })
+		if i == -1 {
+			if i = slices.IndexFunc(msgs, func(m Message) bool { return len(m.Kind) == 0 }); i == -1 {
+				return fe.Error()
+			}
+		}
+
+		m := msgs[i]
+		key := m.key()
+		param := fe.Param()
+
+		f64, digits, err := parseNumber(param)
+
+		switch {
+		case len(m.Plural) > 0:
+			if err != nil {
+				return fe.Error()
+			}
+
+			if param, err = ut.C(key+"-plural", f64, digits, ut.FmtNumber(f64, digits)); err != nil {
+				return fe.Error()
+			}
+
+		case kind == KindNumber && err == nil && fe.Type() != durationType:
+			param = ut.FmtNumber(f64, digits)
+		}
+
+		s, err := ut.T(key, validator.FieldLabel(fe, ut), param)
+		if err != nil {
+			return fe.Error()
+		}
+		return s
+	}
+}
+
+// fieldKind returns the kind of the field of fe a message may be specific to.
+func fieldKind(fe validator.FieldError) string {
+	typ := fe.Type()
+	if typ == nil {
+		return ""
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ == timeType {
+		return KindDatetime
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return KindString
+
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return KindItems
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return KindNumber
+	}
+	return ""
+}
+
+// parseNumber parses the number s, returning the number of its decimal digits.
+func parseNumber(s string) (float64, uint64, error) {
+	var digits uint64
+
+	if i := strings.Index(s, "."); i != -1 {
+		digits = uint64(len(s[i+1:]))
+	}
+
+	f64, err := strconv.ParseFloat(s, 64)
+	return f64, digits, err
+}
+
+// splitKey splits a catalog key into its tag and kind.
+func splitKey(key string) (string, string) {
+	for _, kind := range kinds {
+		if tag, ok := strings.CutSuffix(key, "-"+kind); ok && len(tag) > 0 {
+			return tag, kind
+		}
+	}
+	return key, ""
+}
+
+// fromMap converts a decoded JSON or YAML catalog into a Catalog.
+func fromMap(m map[string]any) (Catalog, error) {
+	var c Catalog
+
+	for key, val := range m {
+		tag, kind := splitKey(key)
+		msg := Message{Tag: tag, Kind: kind}
+
+		switch val := val.(type) {
+		case string:
+			msg.Text = val
+
+		case map[string]any:
+			for field, v := range val {
+				switch field {
+				case "text":
+					s, ok := v.(string)
+					if !ok {
+						return Catalog{}, fmt.Errorf("%s: text must be a string", key)
+					}
+					msg.Text = s
+
+				case "plural":
+					forms, ok := v.(map[string]any)
+					if !ok {
+						return Catalog{}, fmt.Errorf("%s: plural must be a mapping of plural rules to strings", key)
+					}
+
+					msg.Plural = make(map[string]string, len(forms))
+					for rule, form := range forms {
+						s, ok := form.(string)
+						if !ok {
+							return Catalog{}, fmt.Errorf("%s: plural form %s must be a string", key, rule)
+						}
+						msg.Plural[rule] = s
+					}
+
+				default:
+					return Catalog{}, fmt.Errorf("%s: unknown field %s", key, field)
+				}
+			}
+
+			if len(msg.Text) == 0 {
+				return Catalog{}, fmt.Errorf("%s: missing text", key)
+			}
+
+		default:
+			return Catalog{}, fmt.Errorf("%s: message must be a string or a mapping", key)
+		}
+
+		c.Messages = append(c.Messages, msg)
+	}
+
+	c.sort()
+	return c, nil
+}
+
+// sort orders the messages by tag and kind.
+func (c *Catalog) sort() {
+	slices.SortFunc(c.Messages, func(a, b Message) int {
+		if n := strings.Compare(a.Tag, b.Tag); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Kind, b.Kind)
+	})
+}
+
+// syntaxError returns the error of a malformed line of a .po catalog.
+func syntaxError(line int, format string, args ...any) error {
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,154 @@
+package catalog
+
+import (
+	"embed"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	. "github.com/go-playground/assert/v2"
+	english "github.com/go-playground/locales/en"
+	french "github.com/go-playground/locales/fr"
+	dutch "github.com/go-playground/locales/nl"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+)
+
+//go:embed testdata
+var testdata embed.FS
+
+type Test struct {
+	Name    string    `validate:"required"`
+	Code    string    `validate:"min=1"`
+	Codes   string    `validate:"min=3"`
+	Count   int       `validate:"min=1000"`
+	Items   []int     `validate:"min=2"`
+	Started time.Time `validate:"lt"`
+}
+
+func TestRegisterFS(t *testing.T) {
+	eng := english.New()
+	uni := ut.New(eng, eng, french.New(), dutch.New())
+
+	validate := validator.New()
+
+	err := RegisterFS(validate, uni, testdata, "testdata/*")
+	Equal(t, err, nil)
+
+	errs := validate.Struct(Test{Started: time.Now().Add(time.Hour)}).(validator.ValidationErrors)
+	Equal(t, len(errs), 6)
+
+	trans, _ := uni.GetTranslator("en")
+	Equal(t, errs.Translate(trans), validator.ValidationErrorsTranslations{
+		"Test.Name":    "Name is a required field",
+		"Test.Code":    "Code must be at least 1 character in length",
+		"Test.Codes":   "Codes must be at least 3 characters in length",
+		"Test.Count":   "Count must be 1,000 or greater",
+		"Test.Items":   "Items must contain at least 2 items",
+		"Test.Started": "Started must be less than the current Date & Time",
+	})
+
+	trans, _ = uni.GetTranslator("fr")
+	Equal(t, errs[0].Translate(trans), "Name est un champ obligatoire")
+	Equal(t, errs[1].Translate(trans), "Code doit faire au moins 1 caractère de long")
+	Equal(t, errs[2].Translate(trans), "Codes doit faire au moins 3 caractères de long")
+	Equal(t, errs[3].Translate(trans), "Count doit être "+trans.FmtNumber(1000, 0)+" ou plus")
+
+	// no message for the kind of field
+	Equal(t, errs[4].Translate(trans), errs[4].Error())
+
+	trans, _ = uni.GetTranslator("nl")
+	Equal(t, errs[0].Translate(trans), "Name is een verplicht veld")
+	Equal(t, errs[2].Translate(trans), "Codes moet minimaal 3 karakters lang zijn")
+
+	// fuzzy and untranslated entries are skipped
+	Equal(t, errs[3].Translate(trans), errs[3].Error())
+
+	c, err := Load(testdata, "testdata/nl.po")
+	Equal(t, err, nil)
+	Equal(t, c, Catalog{Messages: []Message{
+		{Tag: "min", Kind: KindString, Text: "{0} moet minimaal {1} lang zijn", Plural: map[string]string{"0": "{0} karakter", "1": "{0} karakters"}},
+		{Tag: "required", Text: "{0} is een verplicht veld"},
+	}})
+
+	err = RegisterFS(validate, uni, fstest.MapFS{"de.json": {Data: []byte("{}")}}, "*.json")
+	Equal(t, err.Error(), "catalog: de.json: no translator for locale de")
+
+	err = RegisterFS(validate, uni, fstest.MapFS{"en.txt": {}}, "*")
+	Equal(t, err.Error(), "catalog: unknown format of en.txt")
+}
+
+func TestRegisterOverridesTranslations(t *testing.T) {
+	eng := english.New()
+	uni := ut.New(eng, eng)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := validator.New()
+	Equal(t, en_translations.RegisterDefaultTranslations(validate, trans), nil)
+
+	c, err := Parse([]byte(`{"required": "Please enter your {0}"}`), JSON)
+	Equal(t, err, nil)
+	Equal(t, Register(validate, trans, c), nil)
+
+	errs := validate.Struct(Test{Code: "a", Codes: "abc", Count: 1000, Items: []int{1, 2}, Started: time.Now().Add(time.Hour)}).(validator.ValidationErrors)
+	Equal(t, errs[0].Translate(trans), "Please enter your Name")
+	Equal(t, errs[1].Translate(trans), "Started must be less than the current Date & Time")
+
+	// plural rules the locale lacks
+	c, err = Parse([]byte(`{"min-string": {"text": "{0} {1}", "plural": {"few": "{0} chars"}}}`), JSON)
+	Equal(t, err, nil)
+	NotEqual(t, Register(validate, trans, c), nil)
+
+	c, err = Parse([]byte(`{"min-string": {"text": "{0} {1}", "plural": {"2": "{0} chars"}}}`), JSON)
+	Equal(t, err, nil)
+	Equal(t, Register(validate, trans, c).Error(), "unknown plural rule 2 for min-string in locale en")
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		format Format
+		data   string
+		err    string
+	}{
+		{JSON, `{"required": 1}`, "required: message must be a string or a mapping"},
+		{JSON, `{"min": {"plural": {"one": "a"}}}`, "min: missing text"},
+		{JSON, `{"min": {"text": "a", "one": "b"}}`, "min: unknown field one"},
+		{JSON, `{"min": {"text": "a", "plural": {"one": 1}}}`, "min: plural form one must be a string"},
+		{YAML, "required:\n  - a", "required: message must be a string or a mapping"},
+		{YAML, "required: a\nrequired: b", "yaml: unmarshal errors:\n  line 2: mapping key \"required\" already defined at line 1"},
+		{YAML, "required:", "required: message must be a string or a mapping"},
+		{YAML, "required: \"a", "yaml: found unexpected end of stream"},
+		{YAML, "min:\n  text: a\n  plural: b", "min: plural must be a mapping of plural rules to strings"},
+		{PO, "msgid \"a\"\nmsgstr \"b\"", "line 1: missing msgctxt naming the tag of \"a\""},
+		{PO, "msgctxt \"a\"\nmsgstr[1] \"b\"", "line 2: unexpected msgstr[1]"},
+		{PO, "msgctxt a", "line 1: expected a quoted string"},
+		{PO, "\"a\"", "line 1: unexpected string"},
+		{PO, "msgfoo \"a\"", "line 1: unknown keyword msgfoo"},
+	}
+
+	for _, test := range tests {
+		_, err := Parse([]byte(test.data), test.format)
+		NotEqual(t, err, nil)
+		Equal(t, err.Error(), test.err)
+	}
+
+	c, err := Parse([]byte(`
+# comment
+"required": 'it''s {0}' # comment
+min:
+  text: plain {0} # comment
+  plural:
+    'one': "é"
+    1: "{0} chars"
+`), YAML)
+	Equal(t, err, nil)
+	Equal(t, c, Catalog{Messages: []Message{
+		{Tag: "min", Text: "plain {0}", Plural: map[string]string{"one": "é", "1": "{0} chars"}},
+		{Tag: "required", Text: "it's {0}"},
+	}})
+
+	c, err = Load(fstest.MapFS{"fr.yml": {Data: []byte("required: requis")}}, "fr.yml")
+	Equal(t, err, nil)
+	Equal(t, c, Catalog{Messages: []Message{{Tag: "required", Text: "requis"}}})
+}
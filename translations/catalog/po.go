@@ -0,0 +1,188 @@
+package catalog
+
+import (
+	"bytes"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// poEntry is an entry of a .po catalog.
+type poEntry struct {
+	num    int
+	fuzzy  bool
+	ctxt   string
+	id     string
+	plural string
+	str    string
+	strs   []string
+	hasStr bool
+}
+
+// parsePO parses a gettext .po catalog, the msgctxt of each entry being the key
+// of the message eg. 'min-string'. Entries with a msgid_plural give the plural
+// forms of the parameter.
+func parsePO(data []byte) (Catalog, error) {
+	var entries []poEntry
+	var e poEntry
+	var field *string
+
+	flush := func() {
+		if e.hasStr || len(e.id) > 0 || len(e.ctxt) > 0 {
+			entries = append(entries, e)
+		}
+		e = poEntry{}
+		field = nil
+	}
+
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		num := i + 1
+		s := strings.TrimSpace(string(line))
+
+		switch {
+		case len(s) == 0:
+			flush()
+			continue
+
+		case strings.HasPrefix(s, "#,"):
+			if e.hasStr {
+				flush()
+			}
+			for _, flag := range strings.Split(s[2:], ",") {
+				if strings.TrimSpace(flag) == "fuzzy" {
+					e.fuzzy = true
+				}
+			}
+			continue
+
+		case s[0] == '#':
+			continue
+
+		case s[0] == '"':
+			if field == nil {
+				return Catalog{}, syntaxError(num, "unexpected string")
+			}
+
+			str, err := poString(num, s)
+			if err != nil {
+				return Catalog{}, err
+			}
+			*field += str
+			continue
+		}
+
+		keyword, rest, _ := strings.Cut(s, " ")
+
+		str, err := poString(num, strings.TrimSpace(rest))
+		if err != nil {
+			return Catalog{}, err
+		}
+
+		if (keyword == "msgctxt" || keyword == "msgid") && e.hasStr {
+			flush()
+		}
+
+		if e.num == 0 {
+			e.num = num
+		}
+
+		switch {
+		case keyword == "msgctxt":
+			e.ctxt = str
+			field = &e.ctxt
+
+		case keyword == "msgid":
+			e.id = str
+			field = &e.id
+
+		case keyword == "msgid_plural":
+			e.plural = str
+			field = &e.plural
+
+		case keyword == "msgstr":
+			e.str = str
+			e.hasStr = true
+			field = &e.str
+
+		case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			n, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+			if err != nil || n != len(e.strs) {
+				return Catalog{}, syntaxError(num, "unexpected %s", keyword)
+			}
+
+			e.strs = append(e.strs, str)
+			e.hasStr = true
+			field = &e.strs[n]
+
+		default:
+			return Catalog{}, syntaxError(num, "unknown keyword %s", keyword)
+		}
+	}
+	flush()
+
+	var c Catalog
+	index := make(map[string]int)
+
+	for _, e := range entries {
+		if e.fuzzy {
+			continue
+		}
+
+		if len(e.ctxt) == 0 {
+			// the header has an empty msgid
+			if len(e.id) == 0 {
+				continue
+			}
+			return Catalog{}, syntaxError(e.num, "missing msgctxt naming the tag of %q", e.id)
+		}
+
+		tag, kind := splitKey(e.ctxt)
+
+		i, ok := index[e.ctxt]
+		if !ok {
+			i = len(c.Messages)
+			index[e.ctxt] = i
+			c.Messages = append(c.Messages, Message{Tag: tag, Kind: kind})
+		}
+		m := &c.Messages[i]
+
+		if len(e.plural) == 0 {
+			if len(e.str) > 0 {
+				m.Text = e.str
+			}
+			continue
+		}
+
+		for n, form := range e.strs {
+			if len(form) == 0 {
+				continue
+			}
+
+			if m.Plural == nil {
+				m.Plural = make(map[string]string, len(e.strs))
+			}
+			m.Plural[strconv.Itoa(n)] = form
+		}
+	}
+
+	// untranslated messages are left to other translations
+	c.Messages = slices.DeleteFunc(c.Messages, func(m Message) bool {
+		return len(m.Text) == 0
+	})
+
+	c.sort()
+	return c, nil
+}
+
+// poString parses the quoted string s.
+func poString(num int, s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", syntaxError(num, "expected a quoted string")
+	}
+
+	str, err := strconv.Unquote(s)
+	if err != nil {
+		return "", syntaxError(num, "invalid string %s", s)
+	}
+	return str, nil
+}
@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// parseYAML decodes a YAML catalog into the mappings fromMap converts, the keys
+// of nested mappings eg. the indexes of plural rules being made strings.
+func parseYAML(data []byte) (map[string]any, error) {
+	var m map[string]any
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	for key, val := range m {
+		m[key] = stringKeys(val)
+	}
+	return m, nil
+}
+
+// stringKeys returns val with the keys of any mappings within it as strings.
+func stringKeys(val any) any {
+	switch val := val.(type) {
+	case map[string]any:
+		for key, v := range val {
+			val[key] = stringKeys(v)
+		}
+		return val
+
+	case map[any]any:
+		m := make(map[string]any, len(val))
+		for key, v := range val {
+			m[fmt.Sprint(key)] = stringKeys(v)
+		}
+		return m
+	}
+	return val
+}
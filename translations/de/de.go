@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -134,11 +133,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -158,7 +153,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -168,10 +163,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -223,11 +218,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -247,7 +238,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -257,10 +248,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -312,11 +303,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -336,7 +323,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -346,10 +333,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -366,7 +353,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ist nicht gleich {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -380,7 +367,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} darf nicht gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -434,11 +421,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -463,7 +446,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -478,7 +461,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -486,7 +469,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -494,7 +477,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -551,11 +534,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -580,7 +559,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -595,7 +574,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -603,7 +582,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -611,7 +590,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -668,11 +647,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -697,7 +672,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -712,7 +687,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -720,7 +695,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -728,7 +703,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -785,11 +760,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -814,7 +785,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -829,7 +800,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -837,7 +808,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -845,7 +816,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -862,7 +833,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -876,7 +847,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -890,7 +861,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} darf nicht gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -904,7 +875,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss größer als {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -918,7 +889,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss größer als oder gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -932,7 +903,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss kleiner als {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -946,7 +917,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss kleiner als oder gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -960,7 +931,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} darf nicht gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -974,7 +945,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss größer als {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -988,7 +959,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss größer als oder gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1002,7 +973,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss kleiner als {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1016,7 +987,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss kleiner als oder gleich {1} sein",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1105,7 +1076,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss den Text '{1}' enthalten",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1119,7 +1090,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss mindestens eines der folgenden Zeichen enthalten: '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1133,7 +1104,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} darf den Text '{1}' nicht enthalten",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1147,7 +1118,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} darf keines der folgenden Zeichen enthalten: '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1161,7 +1132,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} darf die folgenden Runen nicht enthalten: '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1360,7 +1331,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss einer der folgenden sein: [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1393,7 +1364,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} entspricht nicht dem {1}-Format",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1407,7 +1378,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} entspricht nicht dem Postleitzahlformat von {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1421,7 +1392,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} entspricht nicht dem Postleitzahlformat des Feldes {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1450,6 +1421,423 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} muss eine gültige CVE-Kennung sein",
 			override:    false,
 		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} ist ein Pflichtfeld",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} muss ohne Berücksichtigung der Groß-/Kleinschreibung gleich {1} sein",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} darf ohne Berücksichtigung der Groß-/Kleinschreibung nicht gleich {1} sein",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} muss den Wert von {1} enthalten",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} darf den Wert von {1} nicht enthalten",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} darf nur alphabetische Zeichen und Leerzeichen enthalten",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} darf nur alphanumerische Zeichen und Leerzeichen enthalten",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} darf nur Unicode-Buchstaben enthalten",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} darf nur Unicode-Buchstaben und -Ziffern enthalten",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} muss eine gültige CMYK-Farbe sein",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} muss eine gültige Base32-Zeichenkette sein",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} muss eine gültige Base64-URL-Zeichenkette sein",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} muss eine gültige Base64-URL-Zeichenkette ohne Auffüllung sein",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} muss das Zeichen '{1}' enthalten",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} muss mit '{1}' beginnen",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} muss mit '{1}' enden",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} darf nicht mit '{1}' beginnen",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} darf nicht mit '{1}' enden",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} muss eine gültige URN nach RFC 2141 sein",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} muss eine gültige URN nach RFC 8141 sein",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} muss eine gültige UUID nach RFC 4122 sein",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} muss eine gültige Version 3 UUID nach RFC 4122 sein",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} muss eine gültige Version 4 UUID nach RFC 4122 sein",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} muss eine gültige Version 5 UUID nach RFC 4122 sein",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} muss ein gültiger MD4-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} muss ein gültiger MD5-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} muss ein gültiger SHA256-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} muss ein gültiger SHA384-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} muss ein gültiger SHA512-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} muss ein gültiger RIPEMD-128-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} muss ein gültiger RIPEMD-160-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} muss ein gültiger TIGER128-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} muss ein gültiger TIGER160-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} muss ein gültiger TIGER192-Hash sein",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} muss eine gültige Bitcoin-Adresse sein",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} muss eine gültige Bech32-Bitcoin-Adresse sein",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} muss eine gültige Ethereum-Adresse sein",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} muss eine gültige Ethereum-Adresse mit Prüfsumme sein",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} muss ein gültiger Hostname sein",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} muss ein gültiger Hostname nach RFC 1123 sein",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} muss ein gültiger Hostname mit Port sein",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} muss eine gültige Portnummer sein",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} muss ein gültiges DNS-Label nach RFC 1035 sein",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} muss eine gültige HTTP-URL sein",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} muss eine gültige HTTPS-URL sein",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} muss ein gültiger Origin sein",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} muss URL-kodiert sein",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} muss HTML-Tags enthalten",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} muss HTML-kodiert sein",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} muss ein existierender Unix-Domain-Socket sein",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} muss ein existierendes Verzeichnis sein",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} muss ein gültiger Verzeichnispfad sein",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} muss eine existierende Datei sein",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} muss ein gültiger Dateipfad sein",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} darf keiner der folgenden sein: [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} muss ohne Berücksichtigung der Groß-/Kleinschreibung einer der folgenden sein: [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} darf ohne Berücksichtigung der Groß-/Kleinschreibung keiner der folgenden sein: [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} muss eine gültige Zeitzone sein",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} muss ein gültiger Ländercode sein",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} muss ein gültiger EU-Ländercode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} muss ein gültiger ISO 3166-1 Alpha-2 Ländercode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} muss ein gültiger ISO 3166-1 Alpha-2 EU-Ländercode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} muss ein gültiger ISO 3166-1 Alpha-3 Ländercode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} muss ein gültiger ISO 3166-1 Alpha-3 EU-Ländercode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} muss ein gültiger numerischer ISO 3166-1 Ländercode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} muss ein gültiger numerischer ISO 3166-1 EU-Ländercode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} muss ein gültiger ISO 3166-2 Regionalcode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} muss ein gültiger ISO 4217 Währungscode sein",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} muss ein gültiger numerischer ISO 4217 Währungscode sein",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} muss ein gültiges BCP 47 Sprachkennzeichen sein",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} muss ein gültiges BCP 47 Sprachkennzeichen sein",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} muss ein gültiger BIC sein",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} muss ein gültiger BIC nach ISO 9362:2014 sein",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} muss eine gültige semantische Version sein",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} muss eine gültige Kreditkartennummer sein",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} muss eine gültige Luhn-Prüfsumme haben",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} muss eine gültige EIN sein",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} muss eine gültige MongoDB-ObjectID sein",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} muss eine gültige MongoDB-Verbindungszeichenfolge sein",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} muss ein gültiger SpiceDB-Bezeichner sein",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} muss ein gültiges Objekt sein",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1482,7 +1870,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
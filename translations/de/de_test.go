@@ -591,7 +591,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber darf nicht gleich 0.00 sein",
+			expected: "NeNumber darf nicht gleich 0,00 sein",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -603,7 +603,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber ist nicht gleich 2.33",
+			expected: "EqNumber ist nicht gleich 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
@@ -813,3 +813,49 @@ func TestTranslations(t *testing.T) {
 		Equal(t, tt.expected, fe.Translate(trans))
 	}
 }
+
+func TestFieldLabels(t *testing.T) {
+	ger := german.New()
+	uni := ut.New(ger, ger)
+	trans, _ := uni.GetTranslator("de")
+
+	validate := validator.New()
+
+	err := RegisterDefaultTranslations(validate, trans)
+	Equal(t, err, nil)
+
+	type User struct {
+		FirstName string `json:"first_name" validate:"required" label_de:"Vorname"`
+		LastName  string `json:"last_name" validate:"required"`
+		Age       int    `json:"age" validate:"gte=18"`
+	}
+
+	validate.RegisterFieldLabel(trans, User{}, "LastName", "Nachname")
+
+	errs := validate.Struct(User{Age: 3}).(validator.ValidationErrors)
+	Equal(t, len(errs), 3)
+	Equal(t, errs[0].Translate(trans), "Vorname ist ein Pflichtfeld")
+	Equal(t, errs[1].Translate(trans), "Nachname ist ein Pflichtfeld")
+	Equal(t, errs[2].Translate(trans), "Age muss 18 oder größer sein")
+}
+
+func TestLocaleFormattedParams(t *testing.T) {
+	ger := german.New()
+	uni := ut.New(ger, ger)
+	trans, _ := uni.GetTranslator("de")
+
+	validate := validator.New()
+	Equal(t, RegisterDefaultTranslations(validate, trans), nil)
+
+	type Limits struct {
+		Count   float64       `validate:"max=1000.5"`
+		Timeout time.Duration `validate:"min=1h"`
+		Retry   time.Duration `validate:"lt=1m30s"`
+	}
+
+	errs := validate.Struct(Limits{Count: 2000, Timeout: time.Minute, Retry: time.Hour}).(validator.ValidationErrors)
+	Equal(t, len(errs), 3)
+	Equal(t, errs[0].Translate(trans), "Count darf 1.000,5 oder weniger sein")
+	Equal(t, errs[1].Translate(trans), "Timeout muss 1 h oder größer sein")
+	Equal(t, errs[2].Translate(trans), "Retry muss kleiner als 1 min 30 s sein")
+}
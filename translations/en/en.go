@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -133,11 +132,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -157,7 +152,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -167,10 +162,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -222,11 +217,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -251,7 +242,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -266,11 +257,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
 					if fe.Type() == reflect.TypeOf(time.Duration(0)) {
-						t, err = ut.T("min-number", fe.Field(), fe.Param())
+						t, err = ut.T("min-number", validator.FieldLabel(fe, ut), fe.Param())
 						goto END
 					}
 
@@ -279,7 +270,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -331,11 +322,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -360,7 +347,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -375,11 +362,11 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
 					if fe.Type() == reflect.TypeOf(time.Duration(0)) {
-						t, err = ut.T("max-number", fe.Field(), fe.Param())
+						t, err = ut.T("max-number", validator.FieldLabel(fe, ut), fe.Param())
 						goto END
 					}
 
@@ -388,7 +375,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -405,7 +392,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} is not equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -419,7 +406,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} should not be equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -473,11 +460,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -502,7 +485,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -517,7 +500,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -525,7 +508,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -533,7 +516,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -590,11 +573,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -619,7 +598,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -634,7 +613,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -642,7 +621,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -650,7 +629,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -707,11 +686,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -736,7 +711,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -751,7 +726,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -759,7 +734,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -767,7 +742,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -824,11 +799,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -853,7 +824,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -868,7 +839,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -876,7 +847,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -884,7 +855,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -901,7 +872,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -915,7 +886,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -929,7 +900,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} cannot be equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -943,7 +914,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be greater than {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -957,7 +928,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be greater than or equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -971,7 +942,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be less than {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -985,7 +956,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be less than or equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -999,7 +970,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} cannot be equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1013,7 +984,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be greater than {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1027,7 +998,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be greater than or equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1041,7 +1012,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be less than {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1055,7 +1026,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be less than or equal to {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1164,7 +1135,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must contain the text '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1178,7 +1149,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must contain at least one of the following characters '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1192,7 +1163,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must start with '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1206,7 +1177,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must end with '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1220,7 +1191,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must not start with '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1234,7 +1205,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must not end with '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1248,7 +1219,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} cannot contain the text '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1262,7 +1233,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} cannot contain any of the following characters '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1276,7 +1247,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} cannot contain the following '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1480,7 +1451,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be one of [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1513,7 +1484,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} does not match the {1} format",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1532,7 +1503,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} does not match postcode format of {1} country",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1546,7 +1517,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} does not match postcode format of country in {1} field",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1585,6 +1556,359 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} must be a valid object",
 			override:    false,
 		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} is a required field",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} must be equal to {1}, ignoring case",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} must not be equal to {1}, ignoring case",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} must contain the value of {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} must not contain the value of {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} must be a valid CMYK color",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} must be a valid Base32 string",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} must be a valid Base64 URL string",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} must be a valid unpadded Base64 URL string",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} must contain the character '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} must be a valid RFC 8141 URN",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} must be a valid RFC 4122 UUID",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} must be a valid version 3 RFC 4122 UUID",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} must be a valid version 4 RFC 4122 UUID",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} must be a valid version 5 RFC 4122 UUID",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} must be a valid MD4 hash",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} must be a valid MD5 hash",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} must be a valid SHA256 hash",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} must be a valid SHA384 hash",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} must be a valid SHA512 hash",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} must be a valid RIPEMD-128 hash",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} must be a valid RIPEMD-160 hash",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} must be a valid TIGER128 hash",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} must be a valid TIGER160 hash",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} must be a valid TIGER192 hash",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} must be a valid Bitcoin address",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} must be a valid Bech32 Bitcoin address",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} must be a valid Ethereum address",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} must be a valid checksummed Ethereum address",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} must be a valid hostname",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} must be a valid RFC 1123 hostname",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} must be a valid hostname and port",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} must be a valid port number",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} must be a valid RFC 1035 DNS label",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} must be a valid HTTP URL",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} must be a valid HTTPS URL",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} must be a valid origin",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} must be URL encoded",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} must contain HTML tags",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} must be HTML encoded",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} must be an existing Unix domain socket",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} must be an existing directory",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} must be a valid directory path",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} must be an existing file",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} must be a valid file path",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} must not be one of [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} must be one of [{1}], ignoring case",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} must not be one of [{1}], ignoring case",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} must be a valid country code",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} must be a valid EU country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} must be a valid ISO 3166-1 alpha-2 country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} must be a valid ISO 3166-1 alpha-2 EU country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} must be a valid ISO 3166-1 alpha-3 country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} must be a valid ISO 3166-1 alpha-3 EU country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} must be a valid ISO 3166-1 numeric country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} must be a valid ISO 3166-1 numeric EU country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} must be a valid ISO 3166-2 subdivision code",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} must be a valid ISO 4217 currency code",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} must be a valid ISO 4217 numeric currency code",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} must be a valid BCP 47 language tag",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} must be a valid BIC code",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} must be a valid ISO 9362:2014 BIC code",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} must be a valid semantic version",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} must be a valid credit card number",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} must have a valid Luhn checksum",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} must be a valid EIN",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} must be a valid MongoDB ObjectID",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} must be a valid MongoDB connection string",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} must be a valid SpiceDB identifier",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1617,7 +1941,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
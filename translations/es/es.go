@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -72,11 +71,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -96,7 +91,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -106,10 +101,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -160,11 +155,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -184,7 +175,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -194,10 +185,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -248,11 +239,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -272,7 +259,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -282,10 +269,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -302,7 +289,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} no es igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -316,7 +303,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} no debería ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -370,11 +357,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -399,7 +382,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -414,7 +397,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -422,7 +405,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -430,7 +413,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -487,11 +470,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -516,7 +495,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -531,7 +510,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -539,7 +518,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -547,7 +526,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -604,11 +583,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -633,7 +608,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -648,7 +623,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -656,7 +631,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -664,7 +639,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -721,11 +696,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -750,7 +721,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -765,7 +736,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -773,7 +744,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -781,7 +752,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -798,7 +769,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -812,7 +783,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -826,7 +797,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} no puede ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -840,7 +811,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser mayor que {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -854,7 +825,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser mayor o igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -868,7 +839,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser menor que {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -882,7 +853,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser menor o igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -896,7 +867,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} no puede ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -910,7 +881,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser mayor que {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -924,7 +895,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser mayor o igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -938,7 +909,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser menor que {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -952,7 +923,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser menor o igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1041,7 +1012,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe contener el texto '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1055,7 +1026,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe contener al menos uno de los siguientes caracteres '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1069,7 +1040,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} no puede contener el texto '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1083,7 +1054,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} no puede contener ninguno de los siguientes caracteres '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1097,7 +1068,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} no puede contener lo siguiente '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1286,7 +1257,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser uno de [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1304,6 +1275,541 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} debe ser un tipo MIME válido",
 			override:    false,
 		},
+		{
+			tag:         "required_unless",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "{0} es un campo excluido",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "{0} es un campo excluido",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} es un campo excluido",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} es un campo excluido",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} es un campo excluido",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} es un campo excluido",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} debe ser el valor predeterminado",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} debe ser igual a {1}, sin distinguir mayúsculas y minúsculas",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} no puede ser igual a {1}, sin distinguir mayúsculas y minúsculas",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} debe contener el valor de {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} no puede contener el valor de {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} sólo puede contener caracteres alfabéticos y espacios",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} sólo puede contener caracteres alfanuméricos y espacios",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} sólo puede contener caracteres alfabéticos unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} sólo puede contener caracteres alfanuméricos unicode",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} debe ser un color CMYK válido",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} debe ser una cadena de Base32 válida",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} debe ser una cadena de Base64 URL válida",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} debe ser una cadena de Base64 URL sin relleno válida",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} debe contener el carácter '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} debe empezar con '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} debe terminar con '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} no puede empezar con '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} no puede terminar con '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} debe ser un URN RFC 2141 válido",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} debe ser un URN RFC 8141 válido",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} debe ser un UUID RFC 4122 válido",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} debe ser un UUID RFC 4122 versión 3 válido",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} debe ser un UUID RFC 4122 versión 4 válido",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} debe ser un UUID RFC 4122 versión 5 válido",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} debe ser un hash MD4 válido",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} debe ser un hash MD5 válido",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} debe ser un hash SHA256 válido",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} debe ser un hash SHA384 válido",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} debe ser un hash SHA512 válido",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} debe ser un hash RIPEMD-128 válido",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} debe ser un hash RIPEMD-160 válido",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} debe ser un hash TIGER128 válido",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} debe ser un hash TIGER160 válido",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} debe ser un hash TIGER192 válido",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} debe ser una dirección de Bitcoin válida",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} debe ser una dirección de Bitcoin Bech32 válida",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} debe ser una dirección de Ethereum válida",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} debe ser una dirección de Ethereum con suma de verificación válida",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} debe ser un nombre de host válido",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} debe ser un nombre de host RFC 1123 válido",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} debe ser un nombre de host y puerto válidos",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} debe ser un FQDN válido",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} debe ser un número de puerto válido",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} debe ser una etiqueta DNS RFC 1035 válida",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} debe ser un URL HTTP válido",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} debe ser un URL HTTPS válido",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} debe ser un origen válido",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} debe estar codificado como URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} debe contener etiquetas HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} debe estar codificado como HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} debe ser un socket de dominio Unix existente",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} debe ser un directorio existente",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} debe ser una ruta de directorio válida",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} debe ser un archivo existente",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} debe ser una ruta de archivo válida",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0} debe ser una expresión cron válida",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} no puede ser uno de [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} debe ser uno de [{1}], sin distinguir mayúsculas y minúsculas",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} no puede ser uno de [{1}], sin distinguir mayúsculas y minúsculas",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "json",
+			translation: "{0} debe ser una cadena json válida",
+			override:    false,
+		},
+		{
+			tag:         "jwt",
+			translation: "{0} debe ser una cadena jwt válida",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} debe ser una cadena en minúsculas",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} debe ser una cadena en mayúsculas",
+			override:    false,
+		},
+		{
+			tag:         "boolean",
+			translation: "{0} debe ser un valor booleano válido",
+			override:    false,
+		},
+		{
+			tag:             "datetime",
+			translation:     "{0} no coincide con el formato {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} debe ser una zona horaria válida",
+			override:    false,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2",
+			translation:     "{0} no coincide con el formato de código postal del país {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2_field",
+			translation:     "{0} no coincide con el formato de código postal del país del campo {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} debe ser un código de país válido",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} debe ser un código de país de la UE válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} debe ser un código de país ISO 3166-1 alfa-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} debe ser un código de país de la UE ISO 3166-1 alfa-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} debe ser un código de país ISO 3166-1 alfa-3 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} debe ser un código de país de la UE ISO 3166-1 alfa-3 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} debe ser un código de país numérico ISO 3166-1 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} debe ser un código de país de la UE numérico ISO 3166-1 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} debe ser un código de subdivisión ISO 3166-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} debe ser un código de moneda ISO 4217 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} debe ser un código de moneda numérico ISO 4217 válido",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} debe ser una etiqueta de idioma BCP 47 válida",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} debe ser una etiqueta de idioma BCP 47 válida",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} debe ser un código BIC válido",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} debe ser un código BIC ISO 9362:2014 válido",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} debe ser una versión semántica válida",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0} debe ser un identificador CVE válido",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} debe ser un número de tarjeta de crédito válido",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} debe tener una suma de verificación de Luhn válida",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} debe ser un EIN válido",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} debe ser un ObjectID de MongoDB válido",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} debe ser una cadena de conexión de MongoDB válida",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} debe ser un identificador de SpiceDB válido",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} debe ser un objeto válido",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1336,7 +1842,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
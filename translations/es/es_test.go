@@ -530,7 +530,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber no debería ser igual a 0.00",
+			expected: "NeNumber no debería ser igual a 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -542,7 +542,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber no es igual a 2.33",
+			expected: "EqNumber no es igual a 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
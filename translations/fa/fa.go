@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -67,11 +66,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -91,7 +86,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -101,10 +96,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -155,11 +150,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -179,7 +170,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -189,10 +180,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -243,11 +234,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -267,7 +254,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -277,10 +264,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -297,7 +284,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} برابر {1} نمیباشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -311,7 +298,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} نباید برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -365,11 +352,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -394,7 +377,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -409,7 +392,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -417,7 +400,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -425,7 +408,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -482,11 +465,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -511,7 +490,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -526,7 +505,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -534,7 +513,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -542,7 +521,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -599,11 +578,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -628,7 +603,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -643,7 +618,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -651,7 +626,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -659,7 +634,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -716,11 +691,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -745,7 +716,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -760,7 +731,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -768,7 +739,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -776,7 +747,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -793,7 +764,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} باید برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -807,7 +778,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} باید برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -821,7 +792,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} نمیتواند برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -835,7 +806,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "طول {0} باید بیشتر از {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -849,7 +820,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "طول {0} باید بیشتر یا برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -863,7 +834,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "طول {0} باید کمتر از {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -877,7 +848,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "طول {0} باید کمتر یا برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -891,7 +862,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} نمیتواند برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -905,7 +876,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "طول {0} باید بیشتر از {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -919,7 +890,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "طول {0} باید بیشتر یا برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -933,7 +904,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "طول {0} باید کمتر از {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -947,7 +918,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "طول {0} باید کمتر یا برابر {1} باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1036,7 +1007,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} باید شامل '{1}' باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1050,7 +1021,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} باید شامل کاراکترهای '{1}' باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1064,7 +1035,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} نمیتواند شامل '{1}' باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1078,7 +1049,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} نمیتواند شامل کاراکترهای '{1}' باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1092,7 +1063,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} نمیتواند شامل '{1}' باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1281,7 +1252,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} باید یکی از مقادیر [{1}] باشد",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1309,7 +1280,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "فرمت {0} با {1} سازگار نیست",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1323,7 +1294,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} یک کدپستی معتبر کشور {1} نیست",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1337,7 +1308,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} یک کدپستی معتبر کشور فیلد {1} نیست",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1356,6 +1327,513 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} باید یک نوع MIME معتبر باشد",
 			override:    false,
 		},
+		{
+			tag:         "required_if",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "فیلد {0} باید خالی باشد",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "فیلد {0} باید خالی باشد",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "فیلد {0} باید خالی باشد",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "فیلد {0} باید خالی باشد",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "فیلد {0} باید خالی باشد",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "فیلد {0} باید خالی باشد",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} باید مقدار پیش‌فرض باشد",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} باید بدون توجه به بزرگی و کوچکی حروف برابر {1} باشد",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} نباید بدون توجه به بزرگی و کوچکی حروف برابر {1} باشد",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} باید شامل مقدار فیلد {1} باشد",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} نمیتواند شامل مقدار فیلد {1} باشد",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} میتواند فقط شامل حروف و فاصله باشد",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} میتواند فقط شامل حروف، اعداد و فاصله باشد",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} میتواند فقط شامل حروف یونیکد باشد",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} میتواند فقط شامل حروف و اعداد یونیکد باشد",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} باید یک کد رنگ CMYK باشد",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} باید یک متن درمبنای32 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} باید یک متن درمبنای64 معتبر برای URL باشد",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} باید یک متن درمبنای64 معتبر برای URL بدون padding باشد",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} باید شامل کاراکتر '{1}' باشد",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} باید با '{1}' شروع شود",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} باید با '{1}' تمام شود",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} نباید با '{1}' شروع شود",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} نباید با '{1}' تمام شود",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} باید یک URN معتبر طبق RFC 2141 باشد",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} باید یک URN معتبر طبق RFC 8141 باشد",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} باید یک UUID معتبر طبق RFC 4122 باشد",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} باید یک UUID نسخه 3 معتبر طبق RFC 4122 باشد",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} باید یک UUID نسخه 4 معتبر طبق RFC 4122 باشد",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} باید یک UUID نسخه 5 معتبر طبق RFC 4122 باشد",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} باید یک هش MD4 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} باید یک هش MD5 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} باید یک هش SHA256 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} باید یک هش SHA384 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} باید یک هش SHA512 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} باید یک هش RIPEMD-128 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} باید یک هش RIPEMD-160 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} باید یک هش TIGER128 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} باید یک هش TIGER160 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} باید یک هش TIGER192 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} باید یک آدرس بیت‌کوین معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} باید یک آدرس بیت‌کوین Bech32 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} باید یک آدرس اتریوم معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} باید یک آدرس اتریوم معتبر با checksum باشد",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} باید یک نام میزبان معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} باید یک نام میزبان معتبر طبق RFC 1123 باشد",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} باید یک نام میزبان و پورت معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} باید یک FQDN معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} باید یک شماره پورت معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} باید یک برچسب DNS معتبر طبق RFC 1035 باشد",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} باید یک آدرس HTTP معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} باید یک آدرس HTTPS معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} باید یک origin معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} باید URL-encoded باشد",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} باید شامل تگ‌های HTML باشد",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} باید HTML-encoded باشد",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} باید یک سوکت دامنه یونیکس موجود باشد",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} باید یک پوشه موجود باشد",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} باید یک مسیر پوشه معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} باید یک فایل موجود باشد",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} باید یک مسیر فایل معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0} باید یک عبارت cron معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} نباید یکی از مقادیر [{1}] باشد",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} باید بدون توجه به بزرگی و کوچکی حروف یکی از مقادیر [{1}] باشد",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} نباید بدون توجه به بزرگی و کوچکی حروف یکی از مقادیر [{1}] باشد",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "jwt",
+			translation: "{0} باید یک JWT معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "boolean",
+			translation: "{0} باید یک مقدار بولی معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} باید یک منطقه زمانی معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} باید یک کد کشور معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} باید یک کد کشور معتبر اتحادیه اروپا باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} باید یک کد کشور ISO 3166-1 alpha-2 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} باید یک کد کشور ISO 3166-1 alpha-2 معتبر اتحادیه اروپا باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} باید یک کد کشور ISO 3166-1 alpha-3 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} باید یک کد کشور ISO 3166-1 alpha-3 معتبر اتحادیه اروپا باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} باید یک کد عددی کشور ISO 3166-1 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} باید یک کد عددی کشور ISO 3166-1 معتبر اتحادیه اروپا باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} باید یک کد منطقه ISO 3166-2 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} باید یک کد ارز ISO 4217 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} باید یک کد عددی ارز ISO 4217 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} باید یک برچسب زبان BCP 47 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} باید یک برچسب زبان BCP 47 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} باید یک کد BIC معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} باید یک کد BIC معتبر طبق ISO 9362:2014 باشد",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} باید یک نسخه معنایی معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0} باید یک شناسه CVE معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} باید یک شماره کارت اعتباری معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} باید یک checksum معتبر Luhn باشد",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} باید یک شماره EIN معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} باید یک ObjectID معتبر MongoDB باشد",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} باید یک رشته اتصال MongoDB معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} باید یک شناسه SpiceDB معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} باید یک شیء معتبر باشد",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1388,7 +1866,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -67,11 +66,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -91,7 +86,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -101,10 +96,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-elements", fe.Field(), c)
+					t, err = ut.T("len-elements", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -155,11 +150,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -179,7 +170,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -189,10 +180,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-elements", fe.Field(), c)
+					t, err = ut.T("min-elements", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -243,11 +234,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -267,7 +254,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -277,10 +264,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-elements", fe.Field(), c)
+					t, err = ut.T("max-elements", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -297,7 +284,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} n'est pas égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -311,7 +298,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ne doit pas être égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -365,11 +352,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -394,7 +377,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -409,7 +392,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-elements", fe.Field(), c)
+					t, err = ut.T("lt-elements", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -417,7 +400,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -425,7 +408,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -482,11 +465,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -511,7 +490,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -526,7 +505,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-elements", fe.Field(), c)
+					t, err = ut.T("lte-elements", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -534,7 +513,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -542,7 +521,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -599,11 +578,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -628,7 +603,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -643,7 +618,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-elements", fe.Field(), c)
+					t, err = ut.T("gt-elements", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -651,7 +626,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -659,7 +634,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -716,11 +691,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -745,7 +716,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -760,7 +731,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-elements", fe.Field(), c)
+					t, err = ut.T("gte-elements", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -768,7 +739,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -776,7 +747,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -793,7 +764,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -807,7 +778,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -821,7 +792,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ne doit pas être égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -835,7 +806,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être supérieur à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -849,7 +820,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être supérieur ou égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -863,7 +834,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être inférieur à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -877,7 +848,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être inférieur ou égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -891,7 +862,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ne doit pas être égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -905,7 +876,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être supérieur à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -919,7 +890,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être supérieur ou égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -933,7 +904,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être inférieur à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -947,7 +918,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être inférieur ou égal à {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1031,7 +1002,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit contenir le texte '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1045,7 +1016,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit contenir au moins l' un des caractères suivants '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1059,7 +1030,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ne doit pas contenir le texte '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1073,7 +1044,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ne doit pas contenir l'un des caractères suivants '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1087,7 +1058,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ne doit pas contenir ce qui suit '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1271,7 +1242,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être l'un des choix suivants [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1289,6 +1260,556 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} doit être un type MIME valide",
 			override:    false,
 		},
+		{
+			tag:         "required_if",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "{0} est un champ exclu",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "{0} est un champ exclu",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} est un champ exclu",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} est un champ exclu",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} est un champ exclu",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} est un champ exclu",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} doit être la valeur par défaut",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} doit être égal à {1}, sans tenir compte de la casse",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} ne doit pas être égal à {1}, sans tenir compte de la casse",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} doit contenir la valeur de {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} ne doit pas contenir la valeur de {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} ne doit contenir que des caractères alphabétiques et des espaces",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} ne doit contenir que des caractères alphanumériques et des espaces",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} ne doit contenir que des caractères alphabétiques unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} ne doit contenir que des caractères alphanumériques unicode",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} doit être une couleur au format CMYK valide",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} doit être un numéro de téléphone au format E.164 valide",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} doit être une chaîne de caractères au format Base32 valide",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} doit être une chaîne de caractères au format Base64 URL valide",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} doit être une chaîne de caractères au format Base64 URL sans remplissage valide",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} doit contenir le caractère '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} doit commencer par '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} doit se terminer par '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} ne doit pas commencer par '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} ne doit pas se terminer par '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} doit être une URN RFC 2141 valide",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} doit être une URN RFC 8141 valide",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} doit être un UUID RFC 4122 valide",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} doit être un UUID RFC 4122 version 3 valide",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} doit être un UUID RFC 4122 version 4 valide",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} doit être un UUID RFC 4122 version 5 valide",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} doit être un hachage MD4 valide",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} doit être un hachage MD5 valide",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} doit être un hachage SHA256 valide",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} doit être un hachage SHA384 valide",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} doit être un hachage SHA512 valide",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} doit être un hachage RIPEMD-128 valide",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} doit être un hachage RIPEMD-160 valide",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} doit être un hachage TIGER128 valide",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} doit être un hachage TIGER160 valide",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} doit être un hachage TIGER192 valide",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} doit être une adresse Bitcoin valide",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} doit être une adresse Bitcoin Bech32 valide",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} doit être une adresse Ethereum valide",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} doit être une adresse Ethereum avec somme de contrôle valide",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} doit être un nom d'hôte valide",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} doit être un nom d'hôte RFC 1123 valide",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} doit être un nom d'hôte et un port valides",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} doit être un FQDN valide",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} doit être un numéro de port valide",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} doit être un libellé DNS RFC 1035 valide",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} doit être une URL HTTP valide",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} doit être une URL HTTPS valide",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} doit être une origine valide",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} doit être encodé au format URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} doit contenir des balises HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} doit être encodé au format HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} doit être un socket de domaine Unix existant",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} doit être un répertoire existant",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} doit être un chemin de répertoire valide",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} doit être un fichier existant",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} doit être un chemin de fichier valide",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0} doit contenir des valeurs uniques",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0} doit être une expression cron valide",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} ne doit pas être l'un des choix suivants [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} doit être l'un des choix suivants [{1}], sans tenir compte de la casse",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} ne doit pas être l'un des choix suivants [{1}], sans tenir compte de la casse",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "json",
+			translation: "{0} doit être une chaîne de caractères au format json valide",
+			override:    false,
+		},
+		{
+			tag:         "jwt",
+			translation: "{0} doit être une chaîne de caractères au format jwt valide",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} doit être une chaîne de caractères en minuscules",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} doit être une chaîne de caractères en majuscules",
+			override:    false,
+		},
+		{
+			tag:         "boolean",
+			translation: "{0} doit être une valeur booléenne valide",
+			override:    false,
+		},
+		{
+			tag:             "datetime",
+			translation:     "{0} ne correspond pas au format {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} doit être un fuseau horaire valide",
+			override:    false,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2",
+			translation:     "{0} ne correspond pas au format de code postal du pays {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2_field",
+			translation:     "{0} ne correspond pas au format de code postal du pays du champ {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} doit être un code pays valide",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} doit être un code pays de l'UE valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} doit être un code pays ISO 3166-1 alpha-2 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} doit être un code pays de l'UE ISO 3166-1 alpha-2 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} doit être un code pays ISO 3166-1 alpha-3 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} doit être un code pays de l'UE ISO 3166-1 alpha-3 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} doit être un code pays numérique ISO 3166-1 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} doit être un code pays de l'UE numérique ISO 3166-1 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} doit être un code de subdivision ISO 3166-2 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} doit être un code de devise ISO 4217 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} doit être un code de devise numérique ISO 4217 valide",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} doit être une étiquette de langue BCP 47 valide",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} doit être une étiquette de langue BCP 47 valide",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} doit être un code BIC valide",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} doit être un code BIC ISO 9362:2014 valide",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} doit être une version sémantique valide",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0} doit être un identifiant CVE valide",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} doit être un numéro de carte de crédit valide",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} doit avoir une somme de contrôle de Luhn valide",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} doit être un EIN valide",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} doit être un ObjectID MongoDB valide",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} doit être une chaîne de connexion MongoDB valide",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} doit être un identifiant SpiceDB valide",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} doit être un objet valide",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1321,7 +1842,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
@@ -523,7 +523,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber ne doit pas être égal à 0.00",
+			expected: "NeNumber ne doit pas être égal à 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -535,7 +535,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber n'est pas égal à 2.33",
+			expected: "EqNumber n'est pas égal à 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -72,11 +71,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -96,7 +91,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -106,10 +101,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -161,11 +156,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -185,7 +176,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -195,10 +186,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -249,11 +240,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -273,7 +260,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -283,10 +270,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -355,11 +342,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -384,7 +367,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -399,7 +382,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -407,7 +390,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -415,7 +398,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -472,11 +455,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -501,7 +480,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -516,7 +495,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -524,7 +503,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -532,7 +511,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -589,11 +568,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -618,7 +593,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -633,7 +608,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -641,7 +616,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -649,7 +624,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -706,11 +681,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -735,7 +706,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -750,7 +721,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -758,7 +729,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -766,7 +737,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -1223,6 +1194,464 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve essere un tipo MIME valido",
 			override:    false,
 		},
+		{
+			tag:         "required_if",
+			translation: "{0} è un campo obbligatorio",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} è un campo obbligatorio",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} è un campo obbligatorio",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} è un campo obbligatorio",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} è un campo obbligatorio",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} è un campo obbligatorio",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "{0} è un campo escluso",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "{0} è un campo escluso",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} è un campo escluso",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} è un campo escluso",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} è un campo escluso",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} è un campo escluso",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} deve essere il valore predefinito",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} deve essere uguale a {1}, senza distinzione tra maiuscole e minuscole",
+			override:        false,
+			customTransFunc: customTransFuncV1,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} deve essere diverso da {1}, senza distinzione tra maiuscole e minuscole",
+			override:        false,
+			customTransFunc: customTransFuncV1,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} deve contenere il valore di {1}",
+			override:        false,
+			customTransFunc: customTransFuncV1,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} non deve contenere il valore di {1}",
+			override:        false,
+			customTransFunc: customTransFuncV1,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} può contenere solo caratteri alfabetici e spazi",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} può contenere solo caratteri alfanumerici e spazi",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} può contenere solo caratteri alfabetici unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} può contenere solo caratteri alfanumerici unicode",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} deve essere un colore CMYK valido",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} deve essere una stringa Base32 valida",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} deve essere una stringa Base64 URL valida",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} deve essere una stringa Base64 URL senza padding valida",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} deve contenere il carattere '{1}'",
+			override:        false,
+			customTransFunc: customTransFuncV1,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} deve essere un URN RFC 2141 valido",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} deve essere un URN RFC 8141 valido",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} deve essere un UUID RFC 4122 valido",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} deve essere un UUID RFC 4122 versione 3 valido",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} deve essere un UUID RFC 4122 versione 4 valido",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} deve essere un UUID RFC 4122 versione 5 valido",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} deve essere un hash MD4 valido",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} deve essere un hash MD5 valido",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} deve essere un hash SHA256 valido",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} deve essere un hash SHA384 valido",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} deve essere un hash SHA512 valido",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} deve essere un hash RIPEMD-128 valido",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} deve essere un hash RIPEMD-160 valido",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} deve essere un hash TIGER128 valido",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} deve essere un hash TIGER160 valido",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} deve essere un hash TIGER192 valido",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} deve essere un indirizzo Bitcoin valido",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} deve essere un indirizzo Bitcoin Bech32 valido",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} deve essere un indirizzo Ethereum valido",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} deve essere un indirizzo Ethereum con checksum valido",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} deve essere un nome host valido",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} deve essere un nome host RFC 1123 valido",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} deve essere un nome host e una porta validi",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} deve essere un FQDN valido",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} deve essere un numero di porta valido",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} deve essere un'etichetta DNS RFC 1035 valida",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} deve essere un URL HTTP valido",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} deve essere un URL HTTPS valido",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} deve essere un'origine valida",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} deve essere codificato come URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} deve contenere tag HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} deve essere codificato come HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} deve essere un socket di dominio Unix esistente",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} deve essere una directory esistente",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} deve essere un percorso di directory valido",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} deve essere un file esistente",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} deve essere un percorso di file valido",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} non deve essere uno di [{1}]",
+			override:        false,
+			customTransFunc: customTransFuncV1,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} deve essere uno di [{1}], senza distinzione tra maiuscole e minuscole",
+			override:        false,
+			customTransFunc: customTransFuncV1,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} non deve essere uno di [{1}], senza distinzione tra maiuscole e minuscole",
+			override:        false,
+			customTransFunc: customTransFuncV1,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} deve essere un fuso orario valido",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} deve essere un codice paese valido",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} deve essere un codice paese UE valido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} deve essere un codice paese ISO 3166-1 alpha-2 valido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} deve essere un codice paese UE ISO 3166-1 alpha-2 valido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} deve essere un codice paese ISO 3166-1 alpha-3 valido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} deve essere un codice paese UE ISO 3166-1 alpha-3 valido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} deve essere un codice paese numerico ISO 3166-1 valido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} deve essere un codice paese UE numerico ISO 3166-1 valido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} deve essere un codice di suddivisione ISO 3166-2 valido",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} deve essere un codice valuta ISO 4217 valido",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} deve essere un codice valuta numerico ISO 4217 valido",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} deve essere uno specificatore di lingua BCP47 valido",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} deve essere un codice BIC valido",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} deve essere un codice BIC ISO 9362:2014 valido",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} deve essere una versione semantica valida",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0} deve essere un identificativo CVE valido",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} deve essere un numero di carta di credito valido",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} deve avere un checksum di Luhn valido",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} deve essere un EIN valido",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} deve essere un ObjectID MongoDB valido",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} deve essere una stringa di connessione MongoDB valida",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} deve essere un identificativo SpiceDB valido",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} deve essere un oggetto valido",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1255,7 +1684,7 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
@@ -1265,7 +1694,7 @@ func translateFunc(ut ut.Translator, fe validator.FieldError) string {
 }
 
 func customTransFuncV1(ut ut.Translator, fe validator.FieldError) string {
-	s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+	s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
@@ -551,7 +551,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber deve essere diverso da 0.00",
+			expected: "NeNumber deve essere diverso da 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -563,7 +563,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber non è uguale a 2.33",
+			expected: "EqNumber non è uguale a 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -72,11 +71,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -96,7 +91,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -106,10 +101,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -160,11 +155,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -184,7 +175,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -194,10 +185,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -248,11 +239,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -272,7 +259,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -282,10 +269,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -302,7 +289,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}と等しくありません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -340,11 +327,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -366,9 +349,9 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("ne-items", fe.Field(), c)
+					t, err = ut.T("ne-items", validator.FieldLabel(fe, ut), c)
 				default:
-					t, err = ut.T("ne", fe.Field(), fe.Param())
+					t, err = ut.T("ne", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -425,11 +408,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -454,7 +433,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -469,7 +448,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -477,7 +456,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -485,7 +464,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -542,11 +521,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -571,7 +546,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -586,7 +561,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -594,7 +569,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -602,7 +577,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -659,11 +634,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -688,7 +659,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -703,7 +674,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -711,7 +682,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -719,7 +690,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -776,11 +747,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -805,7 +772,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -820,7 +787,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -828,7 +795,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -836,7 +803,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -853,7 +820,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}と等しくなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -867,7 +834,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}と等しくなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -881,7 +848,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}とは異ならなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -895,7 +862,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}よりも大きくなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -909,7 +876,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}以上でなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -923,7 +890,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}よりも小さくなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -937,7 +904,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}以下でなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -951,7 +918,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}とは異ならなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -965,7 +932,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}よりも大きくなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -979,7 +946,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}以上でなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -993,7 +960,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}よりも小さくなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1007,7 +974,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}以下でなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1091,7 +1058,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は'{1}'を含まなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1105,7 +1072,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は'{1}'の少なくとも1つを含まなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1119,7 +1086,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}には'{1}'というテキストを含むことはできません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1133,7 +1100,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}には'{1}'のどれも含めることはできません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1147,7 +1114,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}には'{1}'を含めることはできません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1336,7 +1303,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は[{1}]のうちのいずれかでなければなりません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1379,7 +1346,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}の書式と一致しません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1398,7 +1365,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は国名コード{1}の郵便番号形式と一致しません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1412,7 +1379,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は{1}フィールドで指定された国名コードの郵便番号形式と一致しません",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1426,6 +1393,498 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}は正しいブール値でなければなりません",
 			override:    false,
 		},
+		{
+			tag:         "required_unless",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "{0}は除外されるフィールドです",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "{0}は除外されるフィールドです",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0}は除外されるフィールドです",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0}は除外されるフィールドです",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0}は除外されるフィールドです",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0}は除外されるフィールドです",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0}はデフォルト値でなければなりません",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0}は大文字小文字を区別せずに{1}と等しくなければなりません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0}は大文字小文字を区別せずに{1}と異ならなければなりません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0}は{1}の値を含まなければなりません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0}には{1}の値を含めることはできません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0}はアルファベットと空白のみを含むことができます",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0}はアルファベットと数字と空白のみを含むことができます",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0}はユニコード文字のみを含むことができます",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0}はユニコード文字と数字のみを含むことができます",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0}は正しいCMYKカラーコードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0}は正しいE.164形式の電話番号でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0}は正しいBase32文字列でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0}は正しいBase64 URL文字列でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0}は正しいパディングなしのBase64 URL文字列でなければなりません",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0}は文字'{1}'を含まなければなりません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0}は'{1}'で始まらなければなりません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0}は'{1}'で終わらなければなりません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0}は'{1}'で始まってはいけません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0}は'{1}'で終わってはいけません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0}は正しいRFC 2141 URNでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0}は正しいRFC 8141 URNでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0}は正しいRFC 4122 UUIDでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0}はバージョンが3の正しいRFC 4122 UUIDでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0}はバージョンが4の正しいRFC 4122 UUIDでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0}はバージョンが5の正しいRFC 4122 UUIDでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0}は正しいMD4ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0}は正しいMD5ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0}は正しいSHA256ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0}は正しいSHA384ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0}は正しいSHA512ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0}は正しいRIPEMD-128ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0}は正しいRIPEMD-160ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0}は正しいTIGER128ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0}は正しいTIGER160ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0}は正しいTIGER192ハッシュでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0}は正しいビットコインアドレスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0}は正しいBech32ビットコインアドレスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0}は正しいイーサリアムアドレスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0}は正しいチェックサム付きイーサリアムアドレスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0}は正しいホスト名でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0}は正しいRFC 1123ホスト名でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0}は正しいホスト名とポートでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0}は正しいFQDNでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0}は正しいポート番号でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0}は正しいRFC 1035 DNSラベルでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0}は正しいHTTP URLでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0}は正しいHTTPS URLでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0}は正しいオリジンでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0}はURLエンコードされていなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0}はHTMLタグを含まなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0}はHTMLエンコードされていなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0}は存在するUnixドメインソケットでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0}は存在するディレクトリでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0}は正しいディレクトリパスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0}は存在するファイルでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0}は正しいファイルパスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0}は正しいcron式でなければなりません",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0}は[{1}]のいずれでもあってはいけません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0}は大文字小文字を区別せずに[{1}]のうちのいずれかでなければなりません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0}は大文字小文字を区別せずに[{1}]のいずれでもあってはいけません",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0}は正しい国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0}は正しいEUの国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0}は正しいISO 3166-1 alpha-2国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0}は正しいISO 3166-1 alpha-2のEUの国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0}は正しいISO 3166-1 alpha-3国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0}は正しいISO 3166-1 alpha-3のEUの国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0}は正しいISO 3166-1数字国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0}は正しいISO 3166-1数字のEUの国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0}は正しいISO 3166-2地域コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0}は正しいISO 4217通貨コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0}は正しいISO 4217数字通貨コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0}は正しいBCP 47言語タグでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0}は正しいBCP 47言語タグでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0}は正しいBICコードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0}は正しいISO 9362:2014 BICコードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0}は正しいセマンティックバージョンでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0}は正しいCVE識別子でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0}は正しいクレジットカード番号でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0}は正しいLuhnチェックサムでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0}は正しいEIN番号でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0}は正しいMongoDB ObjectIDでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0}は正しいMongoDB接続文字列でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0}は正しいSpiceDB識別子でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0}は正しいオブジェクトでなければなりません",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1458,7 +1917,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
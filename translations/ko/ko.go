@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -126,11 +125,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -150,7 +145,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -160,10 +155,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -207,11 +202,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -231,7 +222,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -241,10 +232,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -288,11 +279,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -312,7 +299,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -322,10 +309,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -342,7 +329,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}와(과) 같아야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -377,11 +364,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -403,9 +386,9 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					if err != nil {
 						goto END
 					}
-					t, err = ut.T("ne-items", fe.Field(), c)
+					t, err = ut.T("ne-items", validator.FieldLabel(fe, ut), c)
 				default:
-					t, err = ut.T("ne", fe.Field(), fe.Param())
+					t, err = ut.T("ne", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -454,11 +437,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -483,7 +462,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -498,7 +477,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -506,7 +485,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -514,7 +493,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -563,11 +542,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -592,7 +567,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -607,7 +582,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -615,7 +590,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -623,7 +598,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -672,11 +647,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -701,7 +672,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -716,7 +687,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -724,7 +695,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -732,7 +703,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -781,11 +752,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -810,7 +777,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -825,7 +792,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -833,7 +800,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -841,7 +808,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -858,7 +825,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}와(과) 같아야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -872,7 +839,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}와(과) 같아야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -886,7 +853,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}와(과) 달라야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -900,7 +867,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}보다 커야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -914,7 +881,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1} 이상여야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -928,7 +895,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}보다 작아야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -942,7 +909,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1} 이하여야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -956,7 +923,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}와(과) 달라야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -970,7 +937,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}보다 커야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -984,7 +951,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1} 이상여야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -998,7 +965,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1}보다 작아야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1012,7 +979,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1} 이하여야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1121,7 +1088,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) '{1}'을(를) 포함해야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1135,7 +1102,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) '{1}' 중 최소 하나를 포함해야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1149,7 +1116,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}에는 '{1}'라는 텍스트를 포함할 수 없습니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1163,7 +1130,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}에는 '{1}' 중 어느 것도 포함할 수 없습니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1177,7 +1144,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}에는 '{1}'을(를) 포함할 수 없습니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1376,7 +1343,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) [{1}] 중 하나여야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1409,7 +1376,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1} 형식과 일치해야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1423,7 +1390,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) 국가 코드 {1}의 우편번호 형식과 일치해야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1437,7 +1404,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) {1} 필드에 지정된 국가 코드의 우편번호 형식과 일치해야 합니다.",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("경고: FieldError 번역 중 오류 발생: %#v", fe)
 					return fe.(error).Error()
@@ -1466,6 +1433,403 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}은(는) 유효한 CVE 식별자여야 합니다.",
 			override:    false,
 		},
+		{
+			tag:         "skip_unless",
+			translation: "{0}은(는) 필수 필드입니다.",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0}은(는) 대소문자 구분 없이 {1}와(과) 같아야 합니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0}은(는) 대소문자 구분 없이 {1}와(과) 달라야 합니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0}은(는) {1}의 값을 포함해야 합니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0}에는 {1}의 값을 포함할 수 없습니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0}은(는) 올바른 CMYK 색상 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0}은(는) 올바른 Base32 문자열여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0}은(는) 올바른 Base64 URL 문자열여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0}은(는) 올바른 패딩 없는 Base64 URL 문자열여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0}은(는) 문자 '{1}'을(를) 포함해야 합니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0}은(는) '{1}'(으)로 시작해야 합니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0}은(는) '{1}'(으)로 끝나야 합니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0}은(는) '{1}'(으)로 시작할 수 없습니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0}은(는) '{1}'(으)로 끝날 수 없습니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0}은(는) 올바른 RFC 2141 URN여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0}은(는) 올바른 RFC 8141 URN여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0}은(는) 올바른 RFC 4122 UUID여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0}은(는) 올바른 버전 3 RFC 4122 UUID여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0}은(는) 올바른 버전 4 RFC 4122 UUID여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0}은(는) 올바른 버전 5 RFC 4122 UUID여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0}은(는) 올바른 MD4 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0}은(는) 올바른 MD5 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0}은(는) 올바른 SHA256 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0}은(는) 올바른 SHA384 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0}은(는) 올바른 SHA512 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0}은(는) 올바른 RIPEMD-128 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0}은(는) 올바른 RIPEMD-160 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0}은(는) 올바른 TIGER128 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0}은(는) 올바른 TIGER160 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0}은(는) 올바른 TIGER192 해시여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0}은(는) 올바른 비트코인 주소여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0}은(는) 올바른 Bech32 비트코인 주소여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0}은(는) 올바른 이더리움 주소여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0}은(는) 올바른 체크섬이 포함된 이더리움 주소여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0}은(는) 올바른 호스트 이름여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0}은(는) 올바른 RFC 1123 호스트 이름여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0}은(는) 올바른 호스트 이름과 포트여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0}은(는) 올바른 포트 번호여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0}은(는) 올바른 RFC 1035 DNS 레이블여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0}은(는) 올바른 HTTP URL여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0}은(는) 올바른 HTTPS URL여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0}은(는) 올바른 오리진여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0}은(는) URL 인코딩되어야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0}은(는) HTML 태그를 포함해야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0}은(는) HTML 인코딩되어야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0}은(는) 존재하는 유닉스 도메인 소켓이어야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0}은(는) 존재하는 디렉터리여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0}은(는) 올바른 디렉터리 경로여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0}은(는) 존재하는 파일이어야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0}은(는) 올바른 파일 경로여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0}은(는) [{1}] 중 하나일 수 없습니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0}은(는) 대소문자 구분 없이 [{1}] 중 하나여야 합니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0}은(는) 대소문자 구분 없이 [{1}] 중 하나일 수 없습니다.",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0}은(는) 올바른 시간대여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0}은(는) 올바른 국가 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0}은(는) 올바른 EU 국가 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0}은(는) 올바른 ISO 3166-1 alpha-2 국가 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0}은(는) 올바른 ISO 3166-1 alpha-2 EU 국가 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0}은(는) 올바른 ISO 3166-1 alpha-3 국가 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0}은(는) 올바른 ISO 3166-1 alpha-3 EU 국가 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0}은(는) 올바른 ISO 3166-1 숫자 국가 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0}은(는) 올바른 ISO 3166-1 숫자 EU 국가 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0}은(는) 올바른 ISO 3166-2 지역 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0}은(는) 올바른 ISO 4217 통화 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0}은(는) 올바른 ISO 4217 숫자 통화 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0}은(는) 올바른 BCP 47 언어 태그여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0}은(는) 올바른 BCP 47 언어 태그여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0}은(는) 올바른 BIC 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0}은(는) 올바른 ISO 9362:2014 BIC 코드여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0}은(는) 올바른 시맨틱 버전여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0}은(는) 올바른 신용카드 번호여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0}은(는) 올바른 Luhn 체크섬여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0}은(는) 올바른 EIN 번호여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0}은(는) 올바른 MongoDB ObjectID여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0}은(는) 올바른 MongoDB 연결 문자열여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0}은(는) 올바른 SpiceDB 식별자여야 합니다.",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0}은(는) 올바른 객체여야 합니다.",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1498,7 +1862,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
@@ -0,0 +1,515 @@
+// Package msgfmt formats translation messages written in a subset of the ICU
+// MessageFormat syntax, so numbers, durations, dates and plurals are formatted
+// for the translator's locale rather than spliced in as written in the tag.
+//
+// Arguments are named within braces, optionally followed by how to format them:
+//
+//	{field}                       the argument as is
+//	{param, number}               a number eg. 1.000,5 for de, or integer or percent
+//	{param, duration}             a time.Duration eg. 1 h 30 min
+//	{value, date}                 a time.Time's date, short, medium, long or full
+//	{value, time}                 a time.Time's time, short, medium, long or full
+//	{param, plural, ...}          a case chosen by the number's plural rule
+//	{param, selectordinal, ...}   a case chosen by the number's ordinal rule
+//	{kind, select, ...}           a case chosen by the argument's value
+//
+// Plural cases are keyed by the locale's cardinal plural rules, zero, one, two,
+// few, many and other, or exact values eg. =0, '#' being replaced by the number
+// formatted for the locale. Select and plural arguments must have an other case:
+//
+//	{field} must be at least {param, plural, one {# character} other {# characters}} in length
+//	{field} must be {kind, select, number {{param, number} or greater} other {at least {param}}}
+//
+// An apostrophe quotes a following '{', '}' or, within a plural, '#', up to the
+// next apostrophe, and two apostrophes are a literal one. Unlike in the ut
+// translations of the translations packages an argument within apostrophes is
+// literal text unless they are doubled:
+//
+//	{field} must contain the text ''{param}''
+//
+// Messages translating validation errors are passed the arguments of Args:
+//
+//	err := msgfmt.RegisterTranslation(validate, trans, "max",
+//		"{field} must be {param, number} or less")
+//
+// The translations packages format the parameters of numeric and duration
+// fields for the locale using Param, and Number for choosing plural forms.
+package msgfmt
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/locales"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// Kinds of field passed as the kind argument by Args.
+const (
+	KindString   = "string"
+	KindNumber   = "number"
+	KindDuration = "duration"
+	KindItems    = "items"
+	KindDatetime = "datetime"
+	KindOther    = "other"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// Message is a parsed message pattern.
+type Message struct {
+	nodes []node
+}
+
+// Format formats the message for the locale of trans with the named arguments.
+func (m *Message) Format(trans ut.Translator, args map[string]any) (string, error) {
+	var b strings.Builder
+
+	if err := m.format(&b, trans, args, ""); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// TranslationFunc returns the TranslationFunc formatting the message with the
+// arguments of the FieldError, falling back to its Error() should the
+// arguments not be formattable eg. a parameter which is not a number.
+func (m *Message) TranslationFunc() validator.TranslationFunc {
+	return func(ut ut.Translator, fe validator.FieldError) string {
+		s, err := m.Format(ut, Args(ut, fe))
+		if err != nil {
+			return fe.Error()
+		}
+		return s
+	}
+}
+
+// RegisterTranslation registers the message pattern as the translation of tag
+// for trans, replacing any registered.
+func RegisterTranslation(v *validator.Validate, trans ut.Translator, tag, pattern string) error {
+	m, err := Parse(pattern)
+	if err != nil {
+		return err
+	}
+
+	return v.RegisterTranslation(tag, trans, func(ut.Translator) error { return nil }, m.TranslationFunc())
+}
+
+// Param returns the parameter of fe formatted for the locale of trans, as a
+// number eg. 1.000,5 for de for numeric fields or a duration eg. 1 h for
+// time.Duration fields, otherwise as written in the tag.
+func Param(trans ut.Translator, fe validator.FieldError) string {
+	param := fe.Param()
+
+	switch fieldKind(fe) {
+	case KindNumber:
+		if f, digits, err := number(param); err == nil {
+			return fmtNumber(trans, param, f, digits)
+		}
+
+	case KindDuration:
+		if d, err := duration(param); err == nil {
+			return formatDuration(trans, d)
+		}
+	}
+	return param
+}
+
+// Number returns the parameter of fe as a number and its number of decimal
+// digits, for choosing the plural form of a message, the parameter of a
+// time.Duration field being its number of seconds.
+func Number(fe validator.FieldError) (float64, uint64, error) {
+	if fieldKind(fe) == KindDuration {
+		d, err := duration(fe.Param())
+		return d.Seconds(), decimals(d.Seconds()), err
+	}
+	return number(fe.Param())
+}
+
+// Args returns the arguments of the message translating fe: field, the field's
+// label; tag and param, the validation tag and its parameter; value, the
+// field's value; and kind, the kind of field, one of the Kind constants.
+func Args(trans ut.Translator, fe validator.FieldError) map[string]any {
+	return map[string]any{
+		"field": validator.FieldLabel(fe, trans),
+		"tag":   fe.Tag(),
+		"param": fe.Param(),
+		"value": fe.Value(),
+		"kind":  fieldKind(fe),
+	}
+}
+
+// format writes the message to b, hash being the number of the enclosing plural.
+func (m *Message) format(b *strings.Builder, trans ut.Translator, args map[string]any, hash string) error {
+	for _, n := range m.nodes {
+		switch {
+		case n.hash:
+			b.WriteString(hash)
+
+		case len(n.arg) == 0:
+			b.WriteString(n.text)
+
+		default:
+			val, ok := args[n.arg]
+			if !ok {
+				return fmt.Errorf("msgfmt: missing argument %s", n.arg)
+			}
+
+			if err := n.format(b, trans, args, val, hash); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// format writes the argument's value val to b.
+func (n *node) format(b *strings.Builder, trans ut.Translator, args map[string]any, val any, hash string) error {
+	switch n.typ {
+	case "":
+		if f, digits, ok := numberValue(val); ok {
+			b.WriteString(fmtNumber(trans, val, f, digits))
+		} else {
+			fmt.Fprint(b, val)
+		}
+
+	case "number":
+		f, digits, err := number(val)
+		if err != nil {
+			return n.errorf(val, err)
+		}
+
+		switch n.style {
+		case "integer":
+			b.WriteString(fmtNumber(trans, val, f, 0))
+		case "percent":
+			b.WriteString(trans.FmtPercent(f*100, max(digits, 2)-2))
+		default:
+			b.WriteString(fmtNumber(trans, val, f, digits))
+		}
+
+	case "duration":
+		d, err := duration(val)
+		if err != nil {
+			return n.errorf(val, err)
+		}
+		b.WriteString(formatDuration(trans, d))
+
+	case "date", "time":
+		t, err := datetime(val)
+		if err != nil {
+			return n.errorf(val, err)
+		}
+		b.WriteString(formatDatetime(trans, n.typ, n.style, t))
+
+	case "plural", "selectordinal":
+		f, digits, err := number(val)
+		if err != nil {
+			return n.errorf(val, err)
+		}
+
+		rule := trans.CardinalPluralRule(f, digits)
+		if n.typ == "selectordinal" {
+			rule = trans.OrdinalPluralRule(f, digits)
+		}
+
+		c := n.choose(func(key string) bool {
+			if exact, ok := strings.CutPrefix(key, "="); ok {
+				e, err := strconv.ParseFloat(exact, 64)
+				return err == nil && e == f
+			}
+			return false
+		}, ruleName(rule))
+
+		return c.msg.format(b, trans, args, fmtNumber(trans, val, f, digits))
+
+	case "select":
+		c := n.choose(func(string) bool { return false }, fmt.Sprint(val))
+		return c.msg.format(b, trans, args, hash)
+	}
+	return nil
+}
+
+// choose returns the first case matching exactly, otherwise the case keyed by
+// key or the other case.
+func (n *node) choose(exact func(key string) bool, key string) *choice {
+	var other *choice
+	var keyed *choice
+
+	for i := range n.cases {
+		c := &n.cases[i]
+
+		switch {
+		case exact(c.key):
+			return c
+		case c.key == key && keyed == nil:
+			keyed = c
+		case c.key == "other" && other == nil:
+			other = c
+		}
+	}
+
+	if keyed != nil {
+		return keyed
+	}
+	return other
+}
+
+// errorf returns the error of val not being formattable by the argument.
+func (n *node) errorf(val any, err error) error {
+	return fmt.Errorf("msgfmt: %s: cannot format %v as %s: %w", n.arg, val, n.typ, err)
+}
+
+// ruleName returns the lower case name of a plural rule eg. 'one'.
+func ruleName(rule locales.PluralRule) string {
+	return strings.ToLower(rule.String())
+}
+
+// number converts val into a number, returning the number of its decimal
+// digits.
+func number(val any) (float64, uint64, error) {
+	if s, ok := val.(string); ok {
+		var digits uint64
+
+		if i := strings.Index(s, "."); i != -1 {
+			digits = uint64(len(s[i+1:]))
+		}
+
+		f64, err := strconv.ParseFloat(s, 64)
+		return f64, digits, err
+	}
+
+	if f, digits, ok := numberValue(val); ok {
+		return f, digits, nil
+	}
+	return 0, 0, errors.New("not a number")
+}
+
+// numberValue converts val into a number if it is one, excluding durations.
+func numberValue(val any) (float64, uint64, bool) {
+	rv := reflect.ValueOf(val)
+	if !rv.IsValid() || rv.Type() == durationType {
+		return 0, 0, false
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), 0, true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), 0, true
+
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return f, decimals(f), true
+	}
+	return 0, 0, false
+}
+
+// fmtNumber formats the number val, converted to f, with digits decimal digits
+// for the locale of trans, integers beyond the precision of a float64 exactly.
+func fmtNumber(trans ut.Translator, val any, f float64, digits uint64) string {
+	const exact = 1 << 53 // float64 holds integers exactly up to this
+
+	if digits > 0 || (f > -exact && f < exact) {
+		return trans.FmtNumber(f, digits)
+	}
+
+	neg, u, ok := integer(val)
+	if !ok {
+		return trans.FmtNumber(f, digits)
+	}
+
+	// format the integer as its millions, exact as a float64, followed by the
+	// digits of its remainder formatted after a leading 1 so they're grouped
+	// and written in the locale's digits
+	hi, lo := float64(u/1e6), float64(u%1e6)
+	if neg {
+		hi = -hi
+	}
+
+	one := trans.FmtNumber(1, 0)
+	return trans.FmtNumber(hi, 0) + strings.TrimPrefix(trans.FmtNumber(1e6+lo, 0), one)
+}
+
+// integer returns the magnitude and sign of val if it is an integer, or a
+// string holding one.
+func integer(val any) (neg bool, u uint64, ok bool) {
+	if s, isString := val.(string); isString {
+		if u, err := strconv.ParseUint(s, 0, 64); err == nil {
+			return false, u, true
+		}
+
+		if i, err := strconv.ParseInt(s, 0, 64); err == nil {
+			return i < 0, uint64(-(i + 1)) + 1, true
+		}
+		return false, 0, false
+	}
+
+	rv := reflect.ValueOf(val)
+	if !rv.IsValid() {
+		return false, 0, false
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		if i < 0 {
+			return true, uint64(-(i + 1)) + 1, true
+		}
+		return false, uint64(i), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return false, rv.Uint(), true
+	}
+	return false, 0, false
+}
+
+// decimals returns the number of decimal digits of f.
+func decimals(f float64) uint64 {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+
+	if i := strings.Index(s, "."); i != -1 {
+		return uint64(len(s[i+1:]))
+	}
+	return 0
+}
+
+// duration converts val, a time.Duration or a string such as '1h30m', into a
+// duration.
+func duration(val any) (time.Duration, error) {
+	switch val := val.(type) {
+	case time.Duration:
+		return val, nil
+	case string:
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			// attempt parsing as an integer number of nanoseconds, as validations do
+			if i, ierr := strconv.ParseInt(val, 0, 64); ierr == nil {
+				return time.Duration(i), nil
+			}
+		}
+		return d, err
+	}
+	return 0, errors.New("not a duration")
+}
+
+// formatDuration formats d in hours, minutes and seconds, or the largest unit
+// of those below a second, using the locale's number format.
+func formatDuration(trans ut.Translator, d time.Duration) string {
+	var sign string
+
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	unit := func(f float64, symbol string) string {
+		return trans.FmtNumber(f, decimals(f)) + " " + symbol
+	}
+
+	switch {
+	case d == 0:
+		return unit(0, "s")
+	case d < time.Microsecond:
+		return sign + unit(float64(d), "ns")
+	case d < time.Millisecond:
+		return sign + unit(float64(d)/float64(time.Microsecond), "µs")
+	case d < time.Second:
+		return sign + unit(float64(d)/float64(time.Millisecond), "ms")
+	}
+
+	var parts []string
+
+	if h := d / time.Hour; h > 0 {
+		parts = append(parts, unit(float64(h), "h"))
+	}
+
+	if m := d % time.Hour / time.Minute; m > 0 {
+		parts = append(parts, unit(float64(m), "min"))
+	}
+
+	if s := d % time.Minute; s > 0 {
+		parts = append(parts, unit(s.Seconds(), "s"))
+	}
+	return sign + strings.Join(parts, " ")
+}
+
+// datetime converts val, a time.Time or pointer to one, into a time.
+func datetime(val any) (time.Time, error) {
+	switch val := val.(type) {
+	case time.Time:
+		return val, nil
+	case *time.Time:
+		if val != nil {
+			return *val, nil
+		}
+	}
+	return time.Time{}, errors.New("not a time.Time")
+}
+
+// formatDatetime formats the date or time of t in the style.
+func formatDatetime(trans ut.Translator, typ, style string, t time.Time) string {
+	if typ == "time" {
+		switch style {
+		case "short":
+			return trans.FmtTimeShort(t)
+		case "long":
+			return trans.FmtTimeLong(t)
+		case "full":
+			return trans.FmtTimeFull(t)
+		}
+		return trans.FmtTimeMedium(t)
+	}
+
+	switch style {
+	case "short":
+		return trans.FmtDateShort(t)
+	case "long":
+		return trans.FmtDateLong(t)
+	case "full":
+		return trans.FmtDateFull(t)
+	}
+	return trans.FmtDateMedium(t)
+}
+
+// fieldKind returns the kind of the field of fe.
+func fieldKind(fe validator.FieldError) string {
+	typ := fe.Type()
+	if typ == nil {
+		return KindOther
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch typ {
+	case timeType:
+		return KindDatetime
+	case durationType:
+		return KindDuration
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return KindString
+
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return KindItems
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return KindNumber
+	}
+	return KindOther
+}
@@ -0,0 +1,184 @@
+package msgfmt_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/go-playground/assert/v2"
+	german "github.com/go-playground/locales/de"
+	english "github.com/go-playground/locales/en"
+	polish "github.com/go-playground/locales/pl"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
+)
+
+func translators() (ut.Translator, ut.Translator, ut.Translator) {
+	eng := english.New()
+	uni := ut.New(eng, eng, german.New(), polish.New())
+
+	en, _ := uni.GetTranslator("en")
+	de, _ := uni.GetTranslator("de")
+	pl, _ := uni.GetTranslator("pl")
+	return en, de, pl
+}
+
+func TestFormat(t *testing.T) {
+	en, de, pl := translators()
+
+	date := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		trans   ut.Translator
+		pattern string
+		args    map[string]any
+		want    string
+	}{
+		{en, "{field} is required", map[string]any{"field": "Name"}, "Name is required"},
+		{en, "{ field } has {n} items", map[string]any{"field": "Tags", "n": 1000}, "Tags has 1,000 items"},
+		{de, "{param, number}", map[string]any{"param": "1000.5"}, "1.000,5"},
+		{de, "{param, number, integer}", map[string]any{"param": 2.75}, "3"},
+		{en, "{param, number, percent}", map[string]any{"param": "0.25"}, "25%"},
+		{de, "{param, duration}", map[string]any{"param": "1h0m0s"}, "1 h"},
+		{de, "{param, duration}", map[string]any{"param": 90*time.Minute + 1500*time.Millisecond}, "1 h 30 min 1,5 s"},
+		{en, "{param, duration}", map[string]any{"param": "250ms"}, "250 ms"},
+		{en, "{param, duration}", map[string]any{"param": "-2s"}, "-2 s"},
+		{en, "{value, date}", map[string]any{"value": date}, en.FmtDateMedium(date)},
+		{de, "{value, date, long}", map[string]any{"value": &date}, de.FmtDateLong(date)},
+		{de, "{value, time, short}", map[string]any{"value": date}, de.FmtTimeShort(date)},
+		{en, "{n, plural, =0 {none} one {# item} other {# items}}", map[string]any{"n": 0}, "none"},
+		{en, "{n, plural, =0 {none} one {# item} other {# items}}", map[string]any{"n": "1"}, "1 item"},
+		{en, "{n, plural, =0 {none} one {# item} other {# items}}", map[string]any{"n": 1200}, "1,200 items"},
+		{pl, "{n, plural, one {# znak} few {# znaki} many {# znaków} other {# znaku}}", map[string]any{"n": 3}, "3 znaki"},
+		{pl, "{n, plural, one {# znak} few {# znaki} many {# znaków} other {# znaku}}", map[string]any{"n": 5}, "5 znaków"},
+		{pl, "{n, plural, one {# znak} few {# znaki} many {# znaków} other {# znaku}}", map[string]any{"n": "1.5"}, "1,5 znaku"},
+		{en, "{n, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}", map[string]any{"n": 22}, "22nd"},
+		{en, "{kind, select, string {{n} chars} other {{n}}}", map[string]any{"kind": "string", "n": 2}, "2 chars"},
+		{en, "{kind, select, string {{n} chars} other {{n}}}", map[string]any{"kind": "items", "n": 2}, "2"},
+		{en, "{n, plural, other {{kind, select, items {# items} other {#}}}}", map[string]any{"kind": "items", "n": 2}, "2 items"},
+		{en, "it''s '{field}' and ''{field}''", map[string]any{"field": "Name"}, "it's {field} and 'Name'"},
+		{en, "{n, plural, other {'#' is #}}", map[string]any{"n": 2}, "# is 2"},
+		{en, "don't # me", nil, "don't # me"},
+		{en, "{n}", map[string]any{"n": int64(9007199254740993)}, "9,007,199,254,740,993"},
+		{de, "{n, number}", map[string]any{"n": "-9223372036854775808"}, "-9.223.372.036.854.775.808"},
+		{en, "{n, plural, other {# items}}", map[string]any{"n": uint64(18446744073709551615)}, "18,446,744,073,709,551,615 items"},
+		{en, "{n, number, integer}", map[string]any{"n": int64(-9007199254740993)}, "-9,007,199,254,740,993"},
+	}
+
+	for _, test := range tests {
+		m, err := msgfmt.Parse(test.pattern)
+		Equal(t, err, nil)
+
+		s, err := m.Format(test.trans, test.args)
+		Equal(t, err, nil)
+		Equal(t, s, test.want)
+	}
+
+	_, err := msgfmt.MustParse("{field}").Format(en, nil)
+	Equal(t, err.Error(), "msgfmt: missing argument field")
+
+	_, err = msgfmt.MustParse("{param, number}").Format(en, map[string]any{"param": "abc"})
+	NotEqual(t, err, nil)
+
+	_, err = msgfmt.MustParse("{param, date}").Format(en, map[string]any{"param": "2024"})
+	Equal(t, err.Error(), "msgfmt: param: cannot format 2024 as date: not a time.Time")
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		err     string
+	}{
+		{"{}", "msgfmt: offset 1: expected an argument name"},
+		{"{field", "msgfmt: offset 6: expected ',' or '}' after field"},
+		{"{field, color}", "msgfmt: offset 13: unknown argument type \"color\""},
+		{"{field, number, money}", "msgfmt: offset 21: unknown number style \"money\""},
+		{"{n, plural, one {#}}", "msgfmt: offset 20: plural of n has no other case"},
+		{"{n, plural, =x {#} other {#}}", "msgfmt: offset 14: invalid plural case =x"},
+		{"{n, plural, other {#}", "msgfmt: offset 21: unterminated plural"},
+		{"{n, select other {#}}", "msgfmt: offset 11: expected ',' after select"},
+		{"a } b", "msgfmt: offset 2: unexpected '}'"},
+	}
+
+	for _, test := range tests {
+		_, err := msgfmt.Parse(test.pattern)
+		NotEqual(t, err, nil)
+		Equal(t, err.Error(), test.err)
+	}
+
+	PanicMatches(t, func() { msgfmt.MustParse("{") }, "msgfmt: offset 1: expected an argument name")
+}
+
+type Test struct {
+	Name    string        `validate:"min=3"`
+	Count   float64       `validate:"max=1000.5"`
+	Items   []int         `validate:"min=2"`
+	Timeout time.Duration `validate:"min=1h"`
+}
+
+func TestRegisterTranslation(t *testing.T) {
+	en, de, _ := translators()
+
+	validate := validator.New()
+	Equal(t, en_translations.RegisterDefaultTranslations(validate, en), nil)
+
+	atLeast := "{field} {kind, select, duration {muss mindestens {param, duration} sein}" +
+		" items {muss mindestens {param, plural, one {# Element} other {# Elemente}} enthalten}" +
+		" other {muss mindestens {param, plural, one {# Zeichen} other {# Zeichen}} lang sein}}"
+
+	Equal(t, msgfmt.RegisterTranslation(validate, de, "min", atLeast), nil)
+	Equal(t, msgfmt.RegisterTranslation(validate, de, "max", "{field} darf höchstens {param, number} sein"), nil)
+	Equal(t, msgfmt.RegisterTranslation(validate, en, "max", "{field} must be {param, number} or less"), nil)
+
+	errs := validate.Struct(Test{Name: "ab", Count: 2000, Items: []int{1}, Timeout: time.Minute}).(validator.ValidationErrors)
+	Equal(t, len(errs), 4)
+
+	Equal(t, errs.Translate(de), validator.ValidationErrorsTranslations{
+		"Test.Name":    "Name muss mindestens 3 Zeichen lang sein",
+		"Test.Count":   "Count darf höchstens 1.000,5 sein",
+		"Test.Items":   "Items muss mindestens 2 Elemente enthalten",
+		"Test.Timeout": "Timeout muss mindestens 1 h sein",
+	})
+
+	// replaces the default translation
+	Equal(t, errs[1].Translate(en), "Count must be 1,000.5 or less")
+
+	Equal(t, msgfmt.RegisterTranslation(validate, en, "max", "{param, plural}").Error(), "msgfmt: offset 14: expected ',' after plural")
+
+	// parameters which are not numbers
+	errs = validate.Var("abcd", "contains=x").(validator.ValidationErrors)
+	Equal(t, msgfmt.RegisterTranslation(validate, en, "contains", "{param, number}"), nil)
+	Equal(t, errs[0].Translate(en), errs[0].Error())
+}
+
+func TestParam(t *testing.T) {
+	en, de, _ := translators()
+
+	type Limits struct {
+		Count   float64       `validate:"max=1000.5"`
+		Total   int64         `validate:"max=9007199254740993"`
+		Timeout time.Duration `validate:"min=1h"`
+		Name    string        `validate:"min=3"`
+		Code    string        `validate:"oneof=a b"`
+	}
+
+	validate := validator.New()
+	errs := validate.Struct(Limits{Count: 2000, Total: 9007199254740995, Timeout: time.Minute}).(validator.ValidationErrors)
+	Equal(t, len(errs), 5)
+
+	Equal(t, msgfmt.Param(de, errs[0]), "1.000,5")
+	Equal(t, msgfmt.Param(en, errs[1]), "9,007,199,254,740,993")
+	Equal(t, msgfmt.Param(de, errs[2]), "1 h")
+	Equal(t, msgfmt.Param(de, errs[3]), "3")
+	Equal(t, msgfmt.Param(de, errs[4]), "a b")
+
+	f, digits, err := msgfmt.Number(errs[0])
+	Equal(t, err, nil)
+	Equal(t, f, 1000.5)
+	Equal(t, digits, uint64(1))
+
+	f, _, err = msgfmt.Number(errs[2])
+	Equal(t, err, nil)
+	Equal(t, f, float64(3600))
+}
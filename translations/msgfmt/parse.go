@@ -0,0 +1,291 @@
+package msgfmt
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// styles are the styles each argument type accepts, the first being the default.
+var styles = map[string][]string{
+	"":         {""},
+	"number":   {"", "integer", "percent"},
+	"duration": {""},
+	"date":     {"medium", "short", "long", "full"},
+	"time":     {"medium", "short", "long", "full"},
+}
+
+// node is literal text, the number of a plural case or an argument.
+type node struct {
+	text  string
+	hash  bool
+	arg   string
+	typ   string
+	style string
+	cases []choice
+}
+
+// choice is a case of a plural, selectordinal or select argument.
+type choice struct {
+	key string
+	msg Message
+}
+
+// parser parses a message pattern.
+type parser struct {
+	s string
+	i int
+}
+
+// Parse parses the message pattern.
+func Parse(pattern string) (*Message, error) {
+	p := parser{s: pattern}
+
+	m, err := p.message(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.i < len(p.s) {
+		return nil, p.errorf("unexpected '}'")
+	}
+	return &m, nil
+}
+
+// MustParse parses the message pattern, panicking if it is malformed.
+func MustParse(pattern string) *Message {
+	m, err := Parse(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// message parses text and arguments up to an unmatched '}' or the end of the
+// pattern, '#' being the number of the enclosing plural when inPlural.
+func (p *parser) message(inPlural bool) (Message, error) {
+	var m Message
+	var b strings.Builder
+
+	flush := func() {
+		if b.Len() > 0 {
+			m.nodes = append(m.nodes, node{text: b.String()})
+			b.Reset()
+		}
+	}
+
+	for p.i < len(p.s) {
+		switch c := p.s[p.i]; {
+		case c == '\'':
+			p.quoted(&b, inPlural)
+
+		case c == '{':
+			flush()
+
+			n, err := p.argument(inPlural)
+			if err != nil {
+				return m, err
+			}
+			m.nodes = append(m.nodes, n)
+
+		case c == '}':
+			flush()
+			return m, nil
+
+		case c == '#' && inPlural:
+			flush()
+			m.nodes = append(m.nodes, node{hash: true})
+			p.i++
+
+		default:
+			b.WriteByte(c)
+			p.i++
+		}
+	}
+
+	flush()
+	return m, nil
+}
+
+// quoted parses an apostrophe, which is literal unless doubled or starting a
+// quoted '{', '}' or, within a plural, '#'.
+func (p *parser) quoted(b *strings.Builder, inPlural bool) {
+	p.i++
+
+	if p.i == len(p.s) {
+		b.WriteByte('\'')
+		return
+	}
+
+	switch c := p.s[p.i]; {
+	case c == '\'':
+		b.WriteByte('\'')
+		p.i++
+		return
+
+	case c != '{' && c != '}' && (c != '#' || !inPlural):
+		b.WriteByte('\'')
+		return
+	}
+
+	for p.i < len(p.s) {
+		c := p.s[p.i]
+		p.i++
+
+		if c != '\'' {
+			b.WriteByte(c)
+			continue
+		}
+
+		// '' is an escaped quote
+		if p.i < len(p.s) && p.s[p.i] == '\'' {
+			b.WriteByte('\'')
+			p.i++
+			continue
+		}
+		return
+	}
+}
+
+// argument parses an argument starting at '{'.
+func (p *parser) argument(inPlural bool) (node, error) {
+	var n node
+	p.i++
+
+	if n.arg = p.ident(); len(n.arg) == 0 {
+		return n, p.errorf("expected an argument name")
+	}
+
+	if p.consume('}') {
+		return n, nil
+	}
+
+	if !p.consume(',') {
+		return n, p.errorf("expected ',' or '}' after %s", n.arg)
+	}
+
+	n.typ = p.ident()
+
+	switch n.typ {
+	case "plural", "selectordinal", "select":
+		if !p.consume(',') {
+			return n, p.errorf("expected ',' after %s", n.typ)
+		}
+		return n, p.cases(&n, inPlural || n.typ != "select")
+	}
+
+	accepted, ok := styles[n.typ]
+	if !ok || len(n.typ) == 0 {
+		return n, p.errorf("unknown argument type %q", n.typ)
+	}
+
+	n.style = accepted[0]
+
+	if p.consume(',') {
+		if n.style = p.ident(); !slices.Contains(accepted, n.style) {
+			return n, p.errorf("unknown %s style %q", n.typ, n.style)
+		}
+	}
+
+	if !p.consume('}') {
+		return n, p.errorf("expected '}' after %s", n.typ)
+	}
+	return n, nil
+}
+
+// cases parses the cases of a plural, selectordinal or select argument up to
+// its closing '}'.
+func (p *parser) cases(n *node, inPlural bool) error {
+	for {
+		p.space()
+
+		if p.i == len(p.s) {
+			return p.errorf("unterminated %s", n.typ)
+		}
+
+		if p.s[p.i] == '}' {
+			p.i++
+			break
+		}
+
+		start := p.i
+		for p.i < len(p.s) && !strings.ContainsRune(" \t\n\r{}", rune(p.s[p.i])) {
+			p.i++
+		}
+		key := p.s[start:p.i]
+
+		if len(key) == 0 {
+			return p.errorf("expected a %s case", n.typ)
+		}
+
+		if n.typ != "select" && key[0] == '=' {
+			if _, err := strconv.ParseFloat(key[1:], 64); err != nil {
+				return p.errorf("invalid %s case %s", n.typ, key)
+			}
+		}
+
+		if !p.consume('{') {
+			return p.errorf("expected '{' after %s", key)
+		}
+
+		msg, err := p.message(inPlural)
+		if err != nil {
+			return err
+		}
+
+		if !p.consume('}') {
+			return p.errorf("unterminated case %s", key)
+		}
+
+		n.cases = append(n.cases, choice{key: key, msg: msg})
+	}
+
+	if !slices.ContainsFunc(n.cases, func(c choice) bool { return c.key == "other" }) {
+		return p.errorf("%s of %s has no other case", n.typ, n.arg)
+	}
+	return nil
+}
+
+// ident parses a name surrounded by optional white space.
+func (p *parser) ident() string {
+	p.space()
+
+	start := p.i
+	for p.i < len(p.s) {
+		c := p.s[p.i]
+		if c != '_' && (c < '0' || c > '9') && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			break
+		}
+		p.i++
+	}
+
+	s := p.s[start:p.i]
+	p.space()
+	return s
+}
+
+// consume skips white space and c, returning false when the pattern does not
+// continue with c.
+func (p *parser) consume(c byte) bool {
+	p.space()
+
+	if p.i == len(p.s) || p.s[p.i] != c {
+		return false
+	}
+
+	p.i++
+	return true
+}
+
+// space skips white space.
+func (p *parser) space() {
+	for p.i < len(p.s) && strings.IndexByte(" \t\n\r", p.s[p.i]) != -1 {
+		p.i++
+	}
+}
+
+// errorf returns the error of a malformed pattern at the current offset.
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("msgfmt: offset %d: %s", p.i, fmt.Sprintf(format, args...))
+}
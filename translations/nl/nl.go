@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -67,11 +66,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -91,7 +86,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -101,10 +96,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -155,11 +150,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -179,7 +170,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -189,10 +180,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -243,11 +234,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -267,7 +254,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -277,10 +264,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -297,7 +284,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} is niet gelijk aan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -311,7 +298,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} mag niet gelijk zijn aan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -365,11 +352,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -394,7 +377,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -409,7 +392,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -417,7 +400,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -425,7 +408,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -482,11 +465,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -511,7 +490,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -526,7 +505,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -534,7 +513,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -542,7 +521,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -599,11 +578,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -628,7 +603,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -643,7 +618,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -651,7 +626,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -659,7 +634,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -716,11 +691,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -745,7 +716,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -760,7 +731,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -768,7 +739,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -776,7 +747,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -793,7 +764,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet gelijk zijn aan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -807,7 +778,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet gelijk zijn aan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -821,7 +792,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} mag niet gelijk zijn aan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -835,7 +806,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet groter zijn dan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -849,7 +820,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet groter dan of gelijk aan {1} zijn",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -863,7 +834,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet kleiner zijn dan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -877,7 +848,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet kleiner dan of gelijk aan {1} zijn",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -891,7 +862,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} mag niet gelijk zijn aan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -905,7 +876,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet groter zijn dan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -919,7 +890,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet groter dan of gelijk aan {1} zijn",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -933,7 +904,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet kleiner zijn dan {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -947,7 +918,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet kleiner dan of gelijk aan {1} zijn",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1031,7 +1002,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet de tekst '{1}' bevatten",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1045,7 +1016,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet tenminste een van de volgende karakters bevatten '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1059,7 +1030,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} mag niet de tekst '{1}' bevatten",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1073,7 +1044,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} mag niet een van de volgende karakters bevatten '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1087,7 +1058,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} mag niet het volgende bevatten '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1271,7 +1242,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet een van de volgende zijn [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1289,6 +1260,556 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} moet een geldig MIME-type zijn",
 			override:    false,
 		},
+		{
+			tag:         "required_if",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "{0} is een uitgesloten veld",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "{0} is een uitgesloten veld",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} is een uitgesloten veld",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} is een uitgesloten veld",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} is een uitgesloten veld",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} is een uitgesloten veld",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} moet de standaardwaarde zijn",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} moet gelijk zijn aan {1}, ongeacht hoofdletters",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} mag niet gelijk zijn aan {1}, ongeacht hoofdletters",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} moet de waarde van {1} bevatten",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} mag niet de waarde van {1} bevatten",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} mag alleen alfabetische karakters en spaties bevatten",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} mag alleen alfanumerieke karakters en spaties bevatten",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} mag alleen unicode alfabetische karakters bevatten",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} mag alleen unicode alfanumerieke karakters bevatten",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} moet een geldige CMYK kleur zijn",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} moet een geldig E.164 telefoonnummer zijn",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} moet een geldige Base32 string zijn",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} moet een geldige Base64 URL string zijn",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} moet een geldige Base64 URL string zonder opvulling zijn",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} moet het karakter '{1}' bevatten",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} moet beginnen met '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} moet eindigen met '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} mag niet beginnen met '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} mag niet eindigen met '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} moet een geldige RFC 2141 URN zijn",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} moet een geldige RFC 8141 URN zijn",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} moet een geldige RFC 4122 UUID zijn",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} moet een geldige versie 3 RFC 4122 UUID zijn",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} moet een geldige versie 4 RFC 4122 UUID zijn",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} moet een geldige versie 5 RFC 4122 UUID zijn",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} moet een geldige MD4 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} moet een geldige MD5 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} moet een geldige SHA256 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} moet een geldige SHA384 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} moet een geldige SHA512 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} moet een geldige RIPEMD-128 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} moet een geldige RIPEMD-160 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} moet een geldige TIGER128 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} moet een geldige TIGER160 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} moet een geldige TIGER192 hash zijn",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} moet een geldig Bitcoin adres zijn",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} moet een geldig Bech32 Bitcoin adres zijn",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} moet een geldig Ethereum adres zijn",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} moet een geldig Ethereum adres met checksum zijn",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} moet een geldige hostnaam zijn",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} moet een geldige RFC 1123 hostnaam zijn",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} moet een geldige hostnaam en poort zijn",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} moet een geldige FQDN zijn",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} moet een geldig poortnummer zijn",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} moet een geldig RFC 1035 DNS label zijn",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} moet een geldige HTTP URL zijn",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} moet een geldige HTTPS URL zijn",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} moet een geldige origin zijn",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} moet URL-gecodeerd zijn",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} moet HTML tags bevatten",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} moet HTML-gecodeerd zijn",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} moet een bestaande Unix domain socket zijn",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} moet een bestaande map zijn",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} moet een geldig mappad zijn",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} moet een bestaand bestand zijn",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} moet een geldig bestandspad zijn",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0} moet unieke waarden bevatten",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0} moet een geldige cron expressie zijn",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} mag niet een van de volgende zijn [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} moet een van de volgende zijn [{1}], ongeacht hoofdletters",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} mag niet een van de volgende zijn [{1}], ongeacht hoofdletters",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "json",
+			translation: "{0} moet een geldige json string zijn",
+			override:    false,
+		},
+		{
+			tag:         "jwt",
+			translation: "{0} moet een geldige jwt string zijn",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} moet een string in kleine letters zijn",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} moet een string in hoofdletters zijn",
+			override:    false,
+		},
+		{
+			tag:         "boolean",
+			translation: "{0} moet een geldige booleaanse waarde zijn",
+			override:    false,
+		},
+		{
+			tag:             "datetime",
+			translation:     "{0} komt niet overeen met het formaat {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} moet een geldige tijdzone zijn",
+			override:    false,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2",
+			translation:     "{0} komt niet overeen met het postcodeformaat van land {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2_field",
+			translation:     "{0} komt niet overeen met het postcodeformaat van het land in veld {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} moet een geldige landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} moet een geldige EU landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} moet een geldige ISO 3166-1 alpha-2 landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} moet een geldige ISO 3166-1 alpha-2 EU landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} moet een geldige ISO 3166-1 alpha-3 landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} moet een geldige ISO 3166-1 alpha-3 EU landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} moet een geldige numerieke ISO 3166-1 landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} moet een geldige numerieke ISO 3166-1 EU landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} moet een geldige ISO 3166-2 regiocode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} moet een geldige ISO 4217 valutacode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} moet een geldige numerieke ISO 4217 valutacode zijn",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} moet een geldige BCP 47 taalcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} moet een geldige BCP 47 taalcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} moet een geldige BIC code zijn",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} moet een geldige ISO 9362:2014 BIC code zijn",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} moet een geldige semantische versie zijn",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0} moet een geldige CVE identificatie zijn",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} moet een geldig creditcardnummer zijn",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} moet een geldige Luhn checksum hebben",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} moet een geldig EIN zijn",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} moet een geldig MongoDB ObjectID zijn",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} moet een geldige MongoDB connection string zijn",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} moet een geldige SpiceDB identificatie zijn",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} moet een geldig object zijn",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1321,7 +1842,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
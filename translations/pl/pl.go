@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -122,11 +121,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -146,7 +141,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -156,10 +151,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -199,11 +194,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -223,7 +214,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -233,10 +224,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -276,11 +267,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -300,7 +287,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -310,10 +297,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -370,11 +357,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -399,7 +382,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -414,7 +397,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -422,7 +405,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -430,7 +413,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -475,11 +458,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -504,7 +483,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -519,7 +498,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -527,7 +506,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -535,7 +514,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -580,11 +559,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -609,7 +584,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -624,7 +599,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -632,7 +607,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -640,7 +615,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -685,11 +660,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -714,7 +685,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -729,7 +700,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -737,7 +708,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -745,7 +716,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -1183,6 +1154,423 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} musi być poprawnym identyfikatorem CVE",
 			override:    false,
 		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} jest wymaganym polem",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} musi być równy {1} bez względu na wielkość liter",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} nie powinien być równy {1} bez względu na wielkość liter",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} musi zawierać wartość pola {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} nie może zawierać wartości pola {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} może zawierać wyłącznie znaki alfabetu i spacje",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} może zawierać wyłącznie znaki alfanumeryczne i spacje",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} może zawierać wyłącznie litery unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} może zawierać wyłącznie litery i cyfry unicode",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} musi być poprawnym kolorem w formacie CMYK",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} musi być ciągiem znaków zakodowanym w formacie Base32",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} musi być ciągiem znaków zakodowanym w formacie Base64 URL",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} musi być ciągiem znaków zakodowanym w formacie Base64 URL bez dopełnienia",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} musi zawierać znak '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} musi zaczynać się od '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} musi kończyć się na '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} nie może zaczynać się od '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} nie może kończyć się na '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} musi być poprawnym URN zgodnym z RFC 2141",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} musi być poprawnym URN zgodnym z RFC 8141",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} musi być poprawnym identyfikatorem UUID zgodnym z RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} musi być poprawnym identyfikatorem UUID w wersji 3 zgodnym z RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} musi być poprawnym identyfikatorem UUID w wersji 4 zgodnym z RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} musi być poprawnym identyfikatorem UUID w wersji 5 zgodnym z RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} musi być poprawnym skrótem MD4",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} musi być poprawnym skrótem MD5",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} musi być poprawnym skrótem SHA256",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} musi być poprawnym skrótem SHA384",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} musi być poprawnym skrótem SHA512",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} musi być poprawnym skrótem RIPEMD-128",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} musi być poprawnym skrótem RIPEMD-160",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} musi być poprawnym skrótem TIGER128",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} musi być poprawnym skrótem TIGER160",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} musi być poprawnym skrótem TIGER192",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} musi być poprawnym adresem Bitcoin",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} musi być poprawnym adresem Bitcoin w formacie Bech32",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} musi być poprawnym adresem Ethereum",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} musi być poprawnym adresem Ethereum z sumą kontrolną",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} musi być poprawną nazwą hosta",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} musi być poprawną nazwą hosta zgodną z RFC 1123",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} musi być poprawną nazwą hosta z portem",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} musi być poprawnym numerem portu",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} musi być poprawną etykietą DNS zgodną z RFC 1035",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} musi być poprawnym adresem URL HTTP",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} musi być poprawnym adresem URL HTTPS",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} musi być poprawnym originem",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} musi być zakodowany w formacie URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} musi zawierać znaczniki HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} musi być zakodowany w formacie HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} musi być istniejącym gniazdem domeny Unix",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} musi być istniejącym katalogiem",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} musi być poprawną ścieżką katalogu",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} musi być istniejącym plikiem",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} musi być poprawną ścieżką pliku",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} nie może być jednym z [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} musi być jednym z [{1}] bez względu na wielkość liter",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} nie może być jednym z [{1}] bez względu na wielkość liter",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} musi być poprawną strefą czasową",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} musi być poprawnym kodem kraju",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} musi być poprawnym kodem kraju UE",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} musi być poprawnym kodem kraju ISO 3166-1 alpha-2",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} musi być poprawnym kodem kraju UE ISO 3166-1 alpha-2",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} musi być poprawnym kodem kraju ISO 3166-1 alpha-3",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} musi być poprawnym kodem kraju UE ISO 3166-1 alpha-3",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} musi być poprawnym numerycznym kodem kraju ISO 3166-1",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} musi być poprawnym numerycznym kodem kraju UE ISO 3166-1",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} musi być poprawnym kodem regionu ISO 3166-2",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} musi być poprawnym kodem waluty ISO 4217",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} musi być poprawnym numerycznym kodem waluty ISO 4217",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} musi być poprawnym znacznikiem języka BCP 47",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} musi być poprawnym znacznikiem języka BCP 47",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} musi być poprawnym kodem BIC",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} musi być poprawnym kodem BIC zgodnym z ISO 9362:2014",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} musi być poprawną wersją semantyczną",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} musi być poprawnym numerem karty kredytowej",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} musi mieć poprawną sumę kontrolną Luhna",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} musi być poprawnym numerem EIN",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} musi być poprawnym identyfikatorem ObjectID MongoDB",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} musi być poprawnym ciągiem połączenia MongoDB",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} musi być poprawnym identyfikatorem SpiceDB",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} musi być poprawnym obiektem",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1215,7 +1603,7 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
@@ -1225,7 +1613,7 @@ func translateFunc(ut ut.Translator, fe validator.FieldError) string {
 }
 
 func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
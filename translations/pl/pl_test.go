@@ -619,7 +619,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber nie powinien być równy 0.00",
+			expected: "NeNumber nie powinien być równy 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -631,7 +631,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber nie równa się 2.33",
+			expected: "EqNumber nie równa się 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
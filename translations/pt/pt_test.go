@@ -538,7 +538,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber não deve ser igual a 0.00",
+			expected: "NeNumber não deve ser igual a 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -550,7 +550,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber não é igual a 2.33",
+			expected: "EqNumber não é igual a 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -66,11 +65,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -90,7 +85,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -100,10 +95,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -153,11 +148,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -177,7 +168,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -187,10 +178,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -241,11 +232,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -265,7 +252,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -275,10 +262,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -295,7 +282,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} não é igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -309,7 +296,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} não deve ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -363,11 +350,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -392,7 +375,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -407,7 +390,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -415,7 +398,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -423,7 +406,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -480,11 +463,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -509,7 +488,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -524,7 +503,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -532,7 +511,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -540,7 +519,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -597,11 +576,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -626,7 +601,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -641,7 +616,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -649,7 +624,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -657,7 +632,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -714,11 +689,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -743,7 +714,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -758,7 +729,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -766,7 +737,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -774,7 +745,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -791,7 +762,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -805,7 +776,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -819,7 +790,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} não deve ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -833,7 +804,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser maior do que {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -847,7 +818,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser maior ou igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -861,7 +832,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser menor que {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -875,7 +846,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser menor ou igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -889,7 +860,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} não deve ser igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -903,7 +874,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser maior do que {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -917,7 +888,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser maior ou igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -931,7 +902,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser menor que {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -945,7 +916,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser menor ou igual a {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1029,7 +1000,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve conter o texto '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1043,7 +1014,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve conter pelo menos um dos caracteres '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1057,7 +1028,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} não deve conter o texto '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1071,7 +1042,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} não deve conter nenhum dos caracteres '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1085,7 +1056,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} não deve conter '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1269,7 +1240,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser um de [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1302,6 +1273,541 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} deve ser um objeto válido",
 			override:    false,
 		},
+		{
+			tag:         "required_if",
+			translation: "{0} é um campo obrigatório",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} é um campo obrigatório",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} é um campo obrigatório",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} é um campo obrigatório",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} é um campo obrigatório",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} é um campo obrigatório",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} é um campo obrigatório",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "{0} é um campo excluído",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "{0} é um campo excluído",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} é um campo excluído",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} é um campo excluído",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} é um campo excluído",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} é um campo excluído",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} deve ser o valor padrão",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} deve ser igual a {1}, sem diferenciar maiúsculas de minúsculas",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} não deve ser igual a {1}, sem diferenciar maiúsculas de minúsculas",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} deve conter o valor de {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} não deve conter o valor de {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} deve conter apenas caracteres alfabéticos e espaços",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} deve conter apenas caracteres alfanuméricos e espaços",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} deve conter apenas caracteres alfabéticos unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} deve conter apenas caracteres alfanuméricos unicode",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} deve ser uma cor CMYK válida",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} deve ser um número de telefone válido no formato E.164",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} deve ser uma string Base32 válida",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} deve ser uma string Base64 URL válida",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} deve ser uma string Base64 URL sem preenchimento válida",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} deve conter o caractere '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} deve começar com '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} deve terminar com '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} não deve começar com '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} não deve terminar com '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} deve ser um URN RFC 2141 válido",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} deve ser um URN RFC 8141 válido",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} deve ser um UUID RFC 4122 válido",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} deve ser um UUID RFC 4122 versão 3 válido",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} deve ser um UUID RFC 4122 versão 4 válido",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} deve ser um UUID RFC 4122 versão 5 válido",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} deve ser um hash MD4 válido",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} deve ser um hash MD5 válido",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} deve ser um hash SHA256 válido",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} deve ser um hash SHA384 válido",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} deve ser um hash SHA512 válido",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} deve ser um hash RIPEMD-128 válido",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} deve ser um hash RIPEMD-160 válido",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} deve ser um hash TIGER128 válido",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} deve ser um hash TIGER160 válido",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} deve ser um hash TIGER192 válido",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} deve ser um endereço Bitcoin válido",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} deve ser um endereço Bitcoin Bech32 válido",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} deve ser um endereço Ethereum válido",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} deve ser um endereço Ethereum com checksum válido",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} deve ser um nome de host válido",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} deve ser um nome de host RFC 1123 válido",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} deve ser um nome de host e porta válidos",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} deve ser um FQDN válido",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} deve ser um número de porta válido",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} deve ser um rótulo DNS RFC 1035 válido",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} deve ser um URL HTTP válido",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} deve ser um URL HTTPS válido",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} deve ser uma origem válida",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} deve estar codificado como URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} deve conter tags HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} deve estar codificado como HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} deve ser um socket de domínio Unix existente",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} deve ser um diretório existente",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} deve ser um caminho de diretório válido",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} deve ser um arquivo existente",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} deve ser um caminho de arquivo válido",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0} deve conter valores únicos",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0} deve ser uma expressão cron válida",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} não deve ser um de [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} deve ser um de [{1}], sem diferenciar maiúsculas de minúsculas",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} não deve ser um de [{1}], sem diferenciar maiúsculas de minúsculas",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "json",
+			translation: "{0} deve ser uma string json válida",
+			override:    false,
+		},
+		{
+			tag:         "jwt",
+			translation: "{0} deve ser uma string jwt válida",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} deve estar em minúsculas",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} deve estar em maiúsculas",
+			override:    false,
+		},
+		{
+			tag:             "datetime",
+			translation:     "{0} não corresponde ao formato {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} deve ser um fuso horário válido",
+			override:    false,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2",
+			translation:     "{0} não corresponde ao formato de código postal do país {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2_field",
+			translation:     "{0} não corresponde ao formato de código postal do país do campo {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} deve ser um código de país válido",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} deve ser um código de país da UE válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} deve ser um código de país ISO 3166-1 alfa-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} deve ser um código de país da UE ISO 3166-1 alfa-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} deve ser um código de país ISO 3166-1 alfa-3 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} deve ser um código de país da UE ISO 3166-1 alfa-3 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} deve ser um código de país numérico ISO 3166-1 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} deve ser um código de país da UE numérico ISO 3166-1 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} deve ser um código de subdivisão ISO 3166-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} deve ser um código de moeda ISO 4217 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} deve ser um código de moeda numérico ISO 4217 válido",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} deve ser uma tag de idioma BCP 47 válida",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} deve ser uma tag de idioma BCP 47 válida",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} deve ser um código BIC válido",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} deve ser um código BIC ISO 9362:2014 válido",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} deve ser uma versão semântica válida",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} deve ser um número de cartão de crédito válido",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} deve ter um dígito verificador de Luhn válido",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} deve ser um EIN válido",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} deve ser um ObjectID do MongoDB válido",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} deve ser uma string de conexão do MongoDB válida",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} deve ser um identificador SpiceDB válido",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1334,7 +1840,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("alerta: erro na tradução FieldError: %#v", fe)
 		return fe.(error).Error()
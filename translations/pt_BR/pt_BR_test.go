@@ -532,7 +532,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber não deve ser igual a 0.00",
+			expected: "NeNumber não deve ser igual a 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -544,7 +544,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber não é igual a 2.33",
+			expected: "EqNumber não é igual a 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
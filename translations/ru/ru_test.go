@@ -589,7 +589,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber должен быть не равен 0.00",
+			expected: "NeNumber должен быть не равен 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -601,7 +601,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber не равен 2.33",
+			expected: "EqNumber не равен 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -66,11 +65,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -90,7 +85,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -100,10 +95,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -147,11 +142,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -171,7 +162,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -181,10 +172,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -228,11 +219,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -252,7 +239,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -262,10 +249,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -282,7 +269,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ไม่เท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -296,7 +283,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องไม่เท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -342,11 +329,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -371,7 +354,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -386,7 +369,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -394,7 +377,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -402,7 +385,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -451,11 +434,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -480,7 +459,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -495,7 +474,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -503,7 +482,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -511,7 +490,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -560,11 +539,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -589,7 +564,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -604,7 +579,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -612,7 +587,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -620,7 +595,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -669,11 +644,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -698,7 +669,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -713,7 +684,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -721,7 +692,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -729,7 +700,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -746,7 +717,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องเท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -760,7 +731,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องเท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -774,7 +745,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องไม่เท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -788,7 +759,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีค่ามากกว่า {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -802,7 +773,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีค่ามากกว่าหรือเท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -816,7 +787,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีค่าน้อยกว่า {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -830,7 +801,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีค่าน้อยกว่าหรือเท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -844,7 +815,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องไม่เท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -858,7 +829,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีค่ามากกว่า {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -872,7 +843,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีค่ามากกว่าหรือเท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -886,7 +857,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีค่าน้อยกว่า {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -900,7 +871,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีค่าน้อยกว่าหรือเท่ากับ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -989,7 +960,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมี '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1003,7 +974,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องมีอย่างน้อยอักขระใน '{1}' อย่างน้อย 1 ตัว",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1017,7 +988,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องไม่มี '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1031,7 +1002,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องไม่มีอักขระ '{1}' ทั้งหมด",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1045,7 +1016,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องไม่มี '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1244,7 +1215,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องอยู่ใน [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1277,7 +1248,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ไม่ตรงกับรูปแบบ {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1291,7 +1262,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} does not match postcode format of {1} country",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1305,7 +1276,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} does not match postcode format of country in {1} field",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1334,6 +1305,483 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} ต้องเป็นรูปแบบ cve",
 			override:    false,
 		},
+		{
+			tag:         "required_unless",
+			translation: "โปรดระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "โปรดระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "โปรดระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "โปรดระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "โปรดระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "โปรดระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "ต้องไม่ระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "ต้องไม่ระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "ต้องไม่ระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "ต้องไม่ระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "ต้องไม่ระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "ต้องไม่ระบุ {0}",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} ต้องเป็นค่าเริ่มต้น",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} ต้องเท่ากับ {1} โดยไม่สนใจตัวพิมพ์เล็กใหญ่",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} ต้องไม่เท่ากับ {1} โดยไม่สนใจตัวพิมพ์เล็กใหญ่",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} ต้องมีค่าของ {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} ต้องไม่มีค่าของ {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} ต้องเป็นตัวอักษรหรือช่องว่างเท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} ต้องเป็นตัวอักษร ตัวเลข หรือช่องว่างเท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} ต้องเป็นตัวอักษรยูนิโค้ดเท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} ต้องเป็นตัวอักษรหรือตัวเลขยูนิโค้ดเท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} ต้องเป็นเลขสี CMYK เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} ต้องเป็น Base32 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} ต้องเป็น Base64 URL เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} ต้องเป็น Base64 URL แบบไม่มี padding เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} ต้องมีอักขระ '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} ต้องขึ้นต้นด้วย '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} ต้องลงท้ายด้วย '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} ต้องไม่ขึ้นต้นด้วย '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} ต้องไม่ลงท้ายด้วย '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} ต้องเป็น URN ตาม RFC 2141 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} ต้องเป็น URN ตาม RFC 8141 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} ต้องเป็น UUID ตาม RFC 4122 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} ต้องเป็น version 3 UUID ตาม RFC 4122 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} ต้องเป็น version 4 UUID ตาม RFC 4122 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} ต้องเป็น version 5 UUID ตาม RFC 4122 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} ต้องเป็นค่าแฮช MD4 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} ต้องเป็นค่าแฮช MD5 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} ต้องเป็นค่าแฮช SHA256 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} ต้องเป็นค่าแฮช SHA384 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} ต้องเป็นค่าแฮช SHA512 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} ต้องเป็นค่าแฮช RIPEMD-128 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} ต้องเป็นค่าแฮช RIPEMD-160 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} ต้องเป็นค่าแฮช TIGER128 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} ต้องเป็นค่าแฮช TIGER160 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} ต้องเป็นค่าแฮช TIGER192 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} ต้องเป็นที่อยู่ Bitcoin เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} ต้องเป็นที่อยู่ Bitcoin แบบ Bech32 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} ต้องเป็นที่อยู่ Ethereum เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} ต้องเป็นที่อยู่ Ethereum ที่มี checksum เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} ต้องเป็นชื่อโฮสต์ เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} ต้องเป็นชื่อโฮสต์ตาม RFC 1123 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} ต้องเป็นชื่อโฮสต์และพอร์ต เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} ต้องเป็นหมายเลขพอร์ต เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} ต้องเป็นป้ายกำกับ DNS ตาม RFC 1035 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} ต้องเป็น HTTP URL เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} ต้องเป็น HTTPS URL เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} ต้องเป็น origin เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} ต้องเข้ารหัสแบบ URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} ต้องมีแท็ก HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} ต้องเข้ารหัสแบบ HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} ต้องเป็น Unix domain socket ที่มีอยู่",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} ต้องเป็นไดเรกทอรีที่มีอยู่",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} ต้องเป็นพาธไดเรกทอรี เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} ต้องเป็นไฟล์ที่มีอยู่",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} ต้องเป็นพาธไฟล์ เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} ต้องไม่เป็นค่าใดค่าหนึ่งใน [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} ต้องเป็นค่าใดค่าหนึ่งใน [{1}] โดยไม่สนใจตัวพิมพ์เล็กใหญ่",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} ต้องไม่เป็นค่าใดค่าหนึ่งใน [{1}] โดยไม่สนใจตัวพิมพ์เล็กใหญ่",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} ต้องเป็นเขตเวลา เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} ต้องเป็นรหัสประเทศ เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} ต้องเป็นรหัสประเทศในสหภาพยุโรป เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} ต้องเป็นรหัสประเทศ ISO 3166-1 alpha-2 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} ต้องเป็นรหัสประเทศในสหภาพยุโรป ISO 3166-1 alpha-2 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} ต้องเป็นรหัสประเทศ ISO 3166-1 alpha-3 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} ต้องเป็นรหัสประเทศในสหภาพยุโรป ISO 3166-1 alpha-3 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} ต้องเป็นรหัสประเทศแบบตัวเลข ISO 3166-1 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} ต้องเป็นรหัสประเทศในสหภาพยุโรปแบบตัวเลข ISO 3166-1 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} ต้องเป็นรหัสเขตการปกครอง ISO 3166-2 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} ต้องเป็นรหัสสกุลเงิน ISO 4217 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} ต้องเป็นรหัสสกุลเงินแบบตัวเลข ISO 4217 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} ต้องเป็นแท็กภาษา BCP 47 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} ต้องเป็นแท็กภาษา BCP 47 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} ต้องเป็นรหัส BIC เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} ต้องเป็นรหัส BIC ตาม ISO 9362:2014 เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} ต้องเป็นเวอร์ชันแบบ semantic เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} ต้องเป็นหมายเลขบัตรเครดิต เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} ต้องเป็น checksum แบบ Luhn เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} ต้องเป็นหมายเลข EIN เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} ต้องเป็น MongoDB ObjectID เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} ต้องเป็นสตริงการเชื่อมต่อ MongoDB เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} ต้องเป็นตัวระบุ SpiceDB เท่านั้น",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} ต้องเป็นอ็อบเจกต์ที่ถูกต้อง",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1366,7 +1814,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
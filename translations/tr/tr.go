@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -66,11 +65,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -90,7 +85,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -100,10 +95,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -154,11 +149,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -178,7 +169,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -188,10 +179,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -242,11 +233,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -266,7 +253,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -276,10 +263,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -296,7 +283,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerine eşit değil",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -310,7 +297,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerine eşit olmamalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -364,11 +351,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -393,7 +376,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -408,7 +391,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -416,7 +399,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -424,7 +407,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -481,11 +464,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -510,7 +489,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -525,7 +504,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -533,7 +512,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -541,7 +520,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -598,11 +577,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -627,7 +602,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -642,7 +617,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -650,7 +625,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -658,7 +633,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -715,11 +690,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -744,7 +715,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -759,7 +730,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -767,7 +738,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -775,7 +746,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -792,7 +763,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerine eşit olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -806,7 +777,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerine eşit olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -820,7 +791,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerine eşit olmamalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -834,7 +805,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerinden büyük olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -848,7 +819,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerinden küçük veya ona eşit olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -862,7 +833,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerinden küçük olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -876,7 +847,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerinden küçük veya ona eşit olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -890,7 +861,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerine eşit olmamalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -904,7 +875,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerinden büyük olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -918,7 +889,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerinden büyük veya ona eşit olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -932,7 +903,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerinden küçük olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -946,7 +917,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, {1} değerinden küçük veya ona eşit olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1030,7 +1001,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, '{1}' metnini içermelidir",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1044,7 +1015,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, '{1}' karakterlerinden en az birini içermelidir",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1058,7 +1029,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, '{1}' metnini içeremez",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1072,7 +1043,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, '{1}' karakterlerinden hiçbirini içeremez",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1086,7 +1057,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, '{1}' ifadesini içeremez",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1275,7 +1246,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}, [{1}]'dan biri olmalıdır",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("warning: error translating FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1293,6 +1264,551 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} gecerli bir MIME turu olmalidir",
 			override:    false,
 		},
+		{
+			tag:         "required_if",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "{0} hariç tutulan bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "{0} hariç tutulan bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} hariç tutulan bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} hariç tutulan bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} hariç tutulan bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} hariç tutulan bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} varsayılan değer olmalıdır",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0}, büyük/küçük harf duyarsız olarak {1} değerine eşit olmalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0}, büyük/küçük harf duyarsız olarak {1} değerine eşit olmamalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0}, {1} alanının değerini içermelidir",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0}, {1} alanının değerini içeremez",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} yalnızca alfabetik karakterler ve boşluk içerebilir",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} yalnızca alfanümerik karakterler ve boşluk içerebilir",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} yalnızca unicode harfler içerebilir",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} yalnızca unicode harfler ve rakamlar içerebilir",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} geçerli bir CMYK rengi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} geçerli bir E.164 formatlı telefon numarası olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} geçerli bir Base32 karakter dizesi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} geçerli bir Base64 URL karakter dizesi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} dolgusuz geçerli bir Base64 URL karakter dizesi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0}, '{1}' karakterini içermelidir",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0}, '{1}' ile başlamalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0}, '{1}' ile bitmelidir",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0}, '{1}' ile başlamamalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0}, '{1}' ile bitmemelidir",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} geçerli bir RFC 2141 URN olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} geçerli bir RFC 8141 URN olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} geçerli bir RFC 4122 UUID olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} geçerli bir sürüm 3 RFC 4122 UUID olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} geçerli bir sürüm 4 RFC 4122 UUID olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} geçerli bir sürüm 5 RFC 4122 UUID olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} geçerli bir MD4 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} geçerli bir MD5 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} geçerli bir SHA256 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} geçerli bir SHA384 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} geçerli bir SHA512 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} geçerli bir RIPEMD-128 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} geçerli bir RIPEMD-160 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} geçerli bir TIGER128 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} geçerli bir TIGER160 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} geçerli bir TIGER192 özeti olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} geçerli bir Bitcoin adresi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} geçerli bir Bech32 Bitcoin adresi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} geçerli bir Ethereum adresi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} sağlama toplamlı geçerli bir Ethereum adresi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} geçerli bir ana bilgisayar adı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} geçerli bir RFC 1123 ana bilgisayar adı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} geçerli bir ana bilgisayar adı ve port olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} geçerli bir FQDN olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} geçerli bir port numarası olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} geçerli bir RFC 1035 DNS etiketi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} geçerli bir HTTP URL olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} geçerli bir HTTPS URL olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} geçerli bir köken (origin) olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} URL kodlu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} HTML etiketleri içermelidir",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} HTML kodlu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} var olan bir Unix domain soketi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} var olan bir dizin olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} geçerli bir dizin yolu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} var olan bir dosya olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} geçerli bir dosya yolu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0} geçerli bir cron ifadesi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0}, [{1}] değerlerinden biri olmamalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0}, büyük/küçük harf duyarsız olarak [{1}] değerlerinden biri olmalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0}, büyük/küçük harf duyarsız olarak [{1}] değerlerinden biri olmamalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "json",
+			translation: "{0} geçerli bir JSON dizesi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "jwt",
+			translation: "{0} geçerli bir JWT olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} yalnızca küçük harfler içermelidir",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} yalnızca büyük harfler içermelidir",
+			override:    false,
+		},
+		{
+			tag:         "boolean",
+			translation: "{0} geçerli bir boolean değer olmalıdır",
+			override:    false,
+		},
+		{
+			tag:             "datetime",
+			translation:     "{0}, {1} biçimiyle eşleşmelidir",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} geçerli bir saat dilimi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2",
+			translation:     "{0}, {1} ülkesi için geçerli bir posta kodu olmalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2_field",
+			translation:     "{0}, {1} alanındaki ülke için geçerli bir posta kodu olmalıdır",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} geçerli bir ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} geçerli bir AB ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} geçerli bir ISO 3166-1 alpha-2 ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} geçerli bir ISO 3166-1 alpha-2 AB ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} geçerli bir ISO 3166-1 alpha-3 ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} geçerli bir ISO 3166-1 alpha-3 AB ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} geçerli bir ISO 3166-1 sayısal ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} geçerli bir ISO 3166-1 sayısal AB ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} geçerli bir ISO 3166-2 bölge kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} geçerli bir ISO 4217 para birimi kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} geçerli bir ISO 4217 sayısal para birimi kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} geçerli bir BCP 47 dil etiketi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} geçerli bir BCP 47 dil etiketi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} geçerli bir BIC kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} geçerli bir ISO 9362:2014 BIC kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} geçerli bir anlamsal sürüm olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0} geçerli bir CVE tanımlayıcısı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} geçerli bir kredi kartı numarası olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} geçerli bir Luhn sağlama toplamı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} geçerli bir EIN numarası olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} geçerli bir MongoDB ObjectID olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} geçerli bir MongoDB bağlantı dizesi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} geçerli bir SpiceDB tanımlayıcısı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} geçerli bir nesne olmalıdır",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1325,7 +1841,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("warning: error translating FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
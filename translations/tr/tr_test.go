@@ -529,7 +529,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber, 0.00 değerine eşit olmamalıdır",
+			expected: "NeNumber, 0,00 değerine eşit olmamalıdır",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -541,7 +541,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber, 2.33 değerine eşit değil",
+			expected: "EqNumber, 2,33 değerine eşit değil",
 		},
 		{
 			ns:       "Test.EqMultiple",
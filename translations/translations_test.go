@@ -0,0 +1,154 @@
+package translations_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/go-playground/assert/v2"
+	"github.com/go-playground/locales"
+	ar_locale "github.com/go-playground/locales/ar"
+	de_locale "github.com/go-playground/locales/de"
+	en_locale "github.com/go-playground/locales/en"
+	es_locale "github.com/go-playground/locales/es"
+	fa_locale "github.com/go-playground/locales/fa"
+	fr_locale "github.com/go-playground/locales/fr"
+	id_locale "github.com/go-playground/locales/id"
+	it_locale "github.com/go-playground/locales/it"
+	ja_locale "github.com/go-playground/locales/ja"
+	ko_locale "github.com/go-playground/locales/ko"
+	lv_locale "github.com/go-playground/locales/lv"
+	nl_locale "github.com/go-playground/locales/nl"
+	pl_locale "github.com/go-playground/locales/pl"
+	pt_locale "github.com/go-playground/locales/pt"
+	pt_BR_locale "github.com/go-playground/locales/pt_BR"
+	ru_locale "github.com/go-playground/locales/ru"
+	th_locale "github.com/go-playground/locales/th"
+	tr_locale "github.com/go-playground/locales/tr"
+	uk_locale "github.com/go-playground/locales/uk"
+	vi_locale "github.com/go-playground/locales/vi"
+	zh_locale "github.com/go-playground/locales/zh"
+	zh_tw_locale "github.com/go-playground/locales/zh_Hant_TW"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	ar_translations "github.com/go-playground/validator/v10/translations/ar"
+	de_translations "github.com/go-playground/validator/v10/translations/de"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	es_translations "github.com/go-playground/validator/v10/translations/es"
+	fa_translations "github.com/go-playground/validator/v10/translations/fa"
+	fr_translations "github.com/go-playground/validator/v10/translations/fr"
+	id_translations "github.com/go-playground/validator/v10/translations/id"
+	it_translations "github.com/go-playground/validator/v10/translations/it"
+	ja_translations "github.com/go-playground/validator/v10/translations/ja"
+	ko_translations "github.com/go-playground/validator/v10/translations/ko"
+	lv_translations "github.com/go-playground/validator/v10/translations/lv"
+	nl_translations "github.com/go-playground/validator/v10/translations/nl"
+	pl_translations "github.com/go-playground/validator/v10/translations/pl"
+	pt_translations "github.com/go-playground/validator/v10/translations/pt"
+	pt_BR_translations "github.com/go-playground/validator/v10/translations/pt_BR"
+	ru_translations "github.com/go-playground/validator/v10/translations/ru"
+	th_translations "github.com/go-playground/validator/v10/translations/th"
+	tr_translations "github.com/go-playground/validator/v10/translations/tr"
+	uk_translations "github.com/go-playground/validator/v10/translations/uk"
+	vi_translations "github.com/go-playground/validator/v10/translations/vi"
+	zh_translations "github.com/go-playground/validator/v10/translations/zh"
+	zh_tw_translations "github.com/go-playground/validator/v10/translations/zh_tw"
+)
+
+type registerFunc func(v *validator.Validate, trans ut.Translator) error
+
+var bundled = []struct {
+	locale   locales.Translator
+	register registerFunc
+}{
+	{ar_locale.New(), ar_translations.RegisterDefaultTranslations},
+	{de_locale.New(), de_translations.RegisterDefaultTranslations},
+	{en_locale.New(), en_translations.RegisterDefaultTranslations},
+	{es_locale.New(), es_translations.RegisterDefaultTranslations},
+	{fa_locale.New(), fa_translations.RegisterDefaultTranslations},
+	{fr_locale.New(), fr_translations.RegisterDefaultTranslations},
+	{id_locale.New(), id_translations.RegisterDefaultTranslations},
+	{it_locale.New(), it_translations.RegisterDefaultTranslations},
+	{ja_locale.New(), ja_translations.RegisterDefaultTranslations},
+	{ko_locale.New(), ko_translations.RegisterDefaultTranslations},
+	{lv_locale.New(), lv_translations.RegisterDefaultTranslations},
+	{nl_locale.New(), nl_translations.RegisterDefaultTranslations},
+	{pl_locale.New(), pl_translations.RegisterDefaultTranslations},
+	{pt_locale.New(), pt_translations.RegisterDefaultTranslations},
+	{pt_BR_locale.New(), pt_BR_translations.RegisterDefaultTranslations},
+	{ru_locale.New(), ru_translations.RegisterDefaultTranslations},
+	{th_locale.New(), th_translations.RegisterDefaultTranslations},
+	{tr_locale.New(), tr_translations.RegisterDefaultTranslations},
+	{uk_locale.New(), uk_translations.RegisterDefaultTranslations},
+	{vi_locale.New(), vi_translations.RegisterDefaultTranslations},
+	{zh_locale.New(), zh_translations.RegisterDefaultTranslations},
+	{zh_tw_locale.New(), zh_tw_translations.RegisterDefaultTranslations},
+}
+
+type Kinds struct {
+	LenString string `validate:"len=2"`
+	LenNumber int    `validate:"len=2"`
+	LenItems  []int  `validate:"len=2"`
+	MinString string `validate:"min=2"`
+	MinNumber int    `validate:"min=2"`
+	MinItems  []int  `validate:"min=2"`
+	MaxString string `validate:"max=1"`
+	MaxNumber int    `validate:"max=1"`
+	MaxItems  []int  `validate:"max=1"`
+}
+
+func TestBakedInCoverage(t *testing.T) {
+	for _, b := range bundled {
+		uni := ut.New(b.locale, b.locale)
+		trans, _ := uni.GetTranslator(b.locale.Locale())
+
+		validate := validator.New()
+
+		err := b.register(validate, trans)
+		Equal(t, err, nil)
+
+		missing := validate.MissingTranslations(trans)
+
+		for _, info := range validate.Validations() {
+			if info.BakedIn && slices.Contains(missing, info.Tag) {
+				t.Errorf("%s: no translation for baked in validation %s", b.locale.Locale(), info.Tag)
+			}
+		}
+
+		// and aliases such as iscolor
+		if len(missing) > 0 {
+			t.Errorf("%s: no translations for %v", b.locale.Locale(), missing)
+		}
+
+		errs := validate.Struct(Kinds{
+			LenString: "a",
+			LenNumber: 1,
+			LenItems:  []int{1},
+			MinString: "a",
+			MinNumber: 1,
+			MinItems:  []int{1},
+			MaxString: "abc",
+			MaxNumber: 3,
+			MaxItems:  []int{1, 2, 3},
+		}).(validator.ValidationErrors)
+		Equal(t, len(errs), 9)
+
+		for i := 0; i < len(errs); i += 3 {
+			msgs := make([]string, 3)
+
+			for j, fe := range errs[i : i+3] {
+				msg := fe.Translate(trans)
+
+				if msg == fe.Error() {
+					t.Errorf("%s: no translation for %s of %s", b.locale.Locale(), fe.Tag(), fe.Field())
+				}
+				msgs[j] = strings.ReplaceAll(msg, fe.Field(), "{0}")
+			}
+
+			// the string, number and items variants of the tag
+			if msgs[0] == msgs[1] || msgs[1] == msgs[2] || msgs[0] == msgs[2] {
+				t.Errorf("%s: %s does not vary by kind: %q", b.locale.Locale(), errs[i].Tag(), msgs)
+			}
+		}
+	}
+}
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -121,11 +120,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -145,7 +140,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -155,10 +150,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 			END:
 				if err != nil {
@@ -197,11 +192,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -221,7 +212,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -231,10 +222,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -274,11 +265,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -298,7 +285,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -308,10 +295,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -368,11 +355,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -397,7 +380,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -412,7 +395,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -420,7 +403,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -428,7 +411,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -473,11 +456,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -502,7 +481,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -517,7 +496,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -525,7 +504,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -533,7 +512,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -578,11 +557,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -607,7 +582,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -622,7 +597,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -630,7 +605,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -638,7 +613,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -683,11 +658,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -712,7 +683,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -727,7 +698,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -735,7 +706,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -743,7 +714,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -1176,6 +1147,428 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} має бути cve ідентифікатором",
 			override:    false,
 		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} обов'язкове поле",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} має дорівнювати {1} без урахування регістру",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} має не дорівнювати {1} без урахування регістру",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} має містити значення {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} не має містити значення {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} має містити тільки літери та пробіли",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} має містити тільки літери, цифри та пробіли",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} має містити тільки літери unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} має містити тільки літери та цифри unicode",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} має бути CMYK кольором",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} має бути Base32 рядком",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} має бути Base64 URL рядком",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} має бути Base64 URL рядком без доповнення",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} має містити символ '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} має починатися з '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} має закінчуватися на '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} не має починатися з '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} не має закінчуватися на '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} має бути URN у форматі RFC 2141",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} має бути URN у форматі RFC 8141",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} має бути UUID у форматі RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} має бути UUID 3 версії у форматі RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} має бути UUID 4 версії у форматі RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} має бути UUID 5 версії у форматі RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} має бути MD4 хешем",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} має бути MD5 хешем",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} має бути SHA256 хешем",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} має бути SHA384 хешем",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} має бути SHA512 хешем",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} має бути RIPEMD-128 хешем",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} має бути RIPEMD-160 хешем",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} має бути TIGER128 хешем",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} має бути TIGER160 хешем",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} має бути TIGER192 хешем",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} має бути Bitcoin адресою",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} має бути Bech32 Bitcoin адресою",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} має бути Ethereum адресою",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} має бути Ethereum адресою з контрольною сумою",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} має бути ім'ям хоста",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} має бути ім'ям хоста у форматі RFC 1123",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} має бути ім'ям хоста та портом",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} має бути номером порту",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} має бути DNS міткою у форматі RFC 1035",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} має бути HTTP URL",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} має бути HTTPS URL",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} має бути origin",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} має бути закодованим як URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} має містити HTML теги",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} має бути закодованим як HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} має бути існуючим Unix сокетом",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} має бути існуючою директорією",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} має бути шляхом до директорії",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} має бути існуючим файлом",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} має бути шляхом до файлу",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0} має бути cron виразом",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} не має бути одним з [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} має бути одним з [{1}] без урахування регістру",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} не має бути одним з [{1}] без урахування регістру",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} має бути часовим поясом",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} має бути кодом країни",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} має бути кодом країни ЄС",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} має бути кодом країни ISO 3166-1 alpha-2",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} має бути кодом країни ЄС ISO 3166-1 alpha-2",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} має бути кодом країни ISO 3166-1 alpha-3",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} має бути кодом країни ЄС ISO 3166-1 alpha-3",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} має бути цифровим кодом країни ISO 3166-1",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} має бути цифровим кодом країни ЄС ISO 3166-1",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} має бути кодом регіону ISO 3166-2",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} має бути кодом валюти ISO 4217",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} має бути цифровим кодом валюти ISO 4217",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} має бути мовним тегом BCP 47",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} має бути мовним тегом BCP 47",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} має бути BIC кодом",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} має бути BIC кодом у форматі ISO 9362:2014",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} має бути семантичною версією",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} має бути номером кредитної картки",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} має мати коректну контрольну суму Луна",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} має бути EIN номером",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} має бути MongoDB ObjectID",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} має бути рядком підключення MongoDB",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} має бути ідентифікатором SpiceDB",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} має бути коректним об'єктом",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1208,7 +1601,7 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
@@ -1218,7 +1611,7 @@ func translateFunc(ut ut.Translator, fe validator.FieldError) string {
 }
 
 func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 	if err != nil {
 		log.Printf("warning: error translating FieldError: %#v", fe)
 		return fe.(error).Error()
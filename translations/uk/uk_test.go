@@ -703,7 +703,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber має не дорівнювати 0.00",
+			expected: "NeNumber має не дорівнювати 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -715,7 +715,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber не дорівнює 2.33",
+			expected: "EqNumber не дорівнює 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
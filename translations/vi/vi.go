@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -67,11 +66,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -91,7 +86,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -101,10 +96,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -155,11 +150,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -179,7 +170,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -189,10 +180,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -243,11 +234,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -267,7 +254,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -277,10 +264,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -297,7 +284,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} không bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -311,7 +298,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} không được bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -365,11 +352,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -394,7 +377,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -409,7 +392,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -417,7 +400,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fe.Field())
+					t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -425,7 +408,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -482,11 +465,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -511,7 +490,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -526,7 +505,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -534,7 +513,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fe.Field())
+					t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -542,7 +521,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -599,11 +578,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -628,7 +603,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -643,7 +618,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -651,7 +626,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fe.Field())
+					t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -659,7 +634,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -716,11 +691,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -745,7 +716,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -760,7 +731,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
@@ -768,7 +739,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fe.Field())
+					t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 
 				default:
 					err = fn()
@@ -776,7 +747,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -793,7 +764,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -807,7 +778,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -821,7 +792,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} không được phép bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -835,7 +806,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải lớn hơn {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -849,7 +820,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải lớn hơn hoặc bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -863,7 +834,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} chỉ được nhỏ hơn {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -877,7 +848,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} chỉ được nhỏ hơn hoặc bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -891,7 +862,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} không được phép bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -905,7 +876,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải lớn hơn {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -919,7 +890,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải lớn hơn hoặc bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -933,7 +904,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} chỉ được nhỏ hơn {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -947,7 +918,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} chỉ được nhỏ hơn hoặc bằng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1036,7 +1007,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải chứa chuỗi '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1050,7 +1021,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải chứa ít nhất 1 trong cách ký tự sau '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1064,7 +1035,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} không được chứa chuỗi '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1078,7 +1049,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} không được chứa bất kỳ ký tự nào trong nhóm ký tự '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1092,7 +1063,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} không được chứa '{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1276,7 +1247,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải là trong những giá trị [{1}]",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1309,7 +1280,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} không trùng định dạng ngày tháng {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1323,7 +1294,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} sai định dạng postcode của quốc gia {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1337,7 +1308,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} sai định dạng postcode của quốc gia tương ứng thuộc trường {1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 					return fe.(error).Error()
@@ -1356,6 +1327,513 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} phải là một loại MIME hợp lệ",
 			override:    false,
 		},
+		{
+			tag:         "required_if",
+			translation: "{0} không được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} không được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} không được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} không được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} không được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} không được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "skip_unless",
+			translation: "{0} không được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "excluded_if",
+			translation: "{0} phải được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "excluded_unless",
+			translation: "{0} phải được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} phải được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} phải được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} phải được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} phải được bỏ trống",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} phải là giá trị mặc định",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0} phải bằng {1} (không phân biệt hoa thường)",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0} không được bằng {1} (không phân biệt hoa thường)",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0} phải chứa giá trị của {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0} không được chứa giá trị của {1}",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0} chỉ được chứa ký tự dạng alphabetic và khoảng trắng",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0} chỉ được chứa ký tự dạng alphanumeric và khoảng trắng",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} chỉ được chứa ký tự unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} chỉ được chứa ký tự và chữ số unicode",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0} phải là giá trị CMYK color",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0} phải là giá trị chuỗi Base32",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} phải là giá trị chuỗi Base64 URL",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0} phải là giá trị chuỗi Base64 URL không có padding",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0} phải chứa ký tự '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0} phải bắt đầu bằng '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0} phải kết thúc bằng '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0} không được bắt đầu bằng '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0} không được kết thúc bằng '{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} phải là giá trị URN theo RFC 2141",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0} phải là giá trị URN theo RFC 8141",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} phải là giá trị UUID theo RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} phải là giá trị UUID phiên bản 3 theo RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} phải là giá trị UUID phiên bản 4 theo RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} phải là giá trị UUID phiên bản 5 theo RFC 4122",
+			override:    false,
+		},
+		{
+			tag:         "ulid",
+			translation: "{0} phải là giá trị ULID",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0} phải là giá trị băm MD4",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0} phải là giá trị băm MD5",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0} phải là giá trị băm SHA256",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0} phải là giá trị băm SHA384",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0} phải là giá trị băm SHA512",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0} phải là giá trị băm RIPEMD-128",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0} phải là giá trị băm RIPEMD-160",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0} phải là giá trị băm TIGER128",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0} phải là giá trị băm TIGER160",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0} phải là giá trị băm TIGER192",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} phải là giá trị địa chỉ Bitcoin",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} phải là giá trị địa chỉ Bitcoin Bech32",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} phải là giá trị địa chỉ Ethereum",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0} phải là giá trị địa chỉ Ethereum có checksum",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} phải là giá trị hostname",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} phải là giá trị hostname theo RFC 1123",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} phải là giá trị hostname và port",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} phải là giá trị FQDN",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0} phải là giá trị số port",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0} phải là giá trị nhãn DNS theo RFC 1035",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0} phải là giá trị HTTP URL",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0} phải là giá trị HTTPS URL",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0} phải là giá trị origin",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} phải được mã hóa URL",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} phải chứa thẻ HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} phải được mã hóa HTML",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0} phải là Unix domain socket đã tồn tại",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} phải là thư mục đã tồn tại",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0} phải là giá trị đường dẫn thư mục",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0} phải là tập tin đã tồn tại",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0} phải là giá trị đường dẫn tập tin",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0} phải là giá trị biểu thức cron",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0} không được là một trong những giá trị [{1}]",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0} phải là một trong những giá trị [{1}] (không phân biệt hoa thường)",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0} không được là một trong những giá trị [{1}] (không phân biệt hoa thường)",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "boolean",
+			translation: "{0} phải là giá trị boolean",
+			override:    false,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} phải là giá trị múi giờ",
+			override:    false,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} phải là giá trị mã quốc gia",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0} phải là giá trị mã quốc gia EU",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} phải là giá trị mã quốc gia ISO 3166-1 alpha-2",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0} phải là giá trị mã quốc gia EU ISO 3166-1 alpha-2",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} phải là giá trị mã quốc gia ISO 3166-1 alpha-3",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0} phải là giá trị mã quốc gia EU ISO 3166-1 alpha-3",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} phải là giá trị mã số quốc gia ISO 3166-1",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0} phải là giá trị mã số quốc gia EU ISO 3166-1",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} phải là giá trị mã vùng ISO 3166-2",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0} phải là giá trị mã tiền tệ ISO 4217",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0} phải là giá trị mã số tiền tệ ISO 4217",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} phải là giá trị thẻ ngôn ngữ BCP 47",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0} phải là giá trị thẻ ngôn ngữ BCP 47",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} phải là giá trị mã BIC",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0} phải là giá trị mã BIC theo ISO 9362:2014",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0} phải là giá trị phiên bản ngữ nghĩa (semver)",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0} phải là giá trị mã CVE",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0} phải là giá trị số thẻ tín dụng",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0} phải là giá trị checksum Luhn",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0} phải là giá trị số EIN",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0} phải là giá trị MongoDB ObjectID",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0} phải là giá trị chuỗi kết nối MongoDB",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0} phải là giá trị định danh SpiceDB",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0} phải là giá trị đối tượng hợp lệ",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1388,7 +1866,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("cảnh báo: lỗi chuyển ngữ FieldError: %#v", fe)
 		return fe.(error).Error()
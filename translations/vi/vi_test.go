@@ -536,7 +536,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.NeNumber",
-			expected: "NeNumber không được bằng 0.00",
+			expected: "NeNumber không được bằng 0,00",
 		},
 		{
 			ns:       "Test.NeMultiple",
@@ -548,7 +548,7 @@ func TestTranslations(t *testing.T) {
 		},
 		{
 			ns:       "Test.EqNumber",
-			expected: "EqNumber không bằng 2.33",
+			expected: "EqNumber không bằng 2,33",
 		},
 		{
 			ns:       "Test.EqMultiple",
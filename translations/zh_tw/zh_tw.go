@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/translations/msgfmt"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
@@ -132,11 +131,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -156,7 +151,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-string", fe.Field(), c)
+					t, err = ut.T("len-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -166,10 +161,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("len-items", fe.Field(), c)
+					t, err = ut.T("len-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("len-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -220,11 +215,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -244,7 +235,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-string", fe.Field(), c)
+					t, err = ut.T("min-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -254,10 +245,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("min-items", fe.Field(), c)
+					t, err = ut.T("min-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("min-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -308,11 +299,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
-				}
-
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := msgfmt.Number(fe)
 				if err != nil {
 					goto END
 				}
@@ -332,7 +319,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-string", fe.Field(), c)
+					t, err = ut.T("max-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -342,10 +329,10 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("max-items", fe.Field(), c)
+					t, err = ut.T("max-items", validator.FieldLabel(fe, ut), c)
 
 				default:
-					t, err = ut.T("max-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -362,7 +349,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}不等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -376,7 +363,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}不能等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				if err != nil {
 					fmt.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -430,11 +417,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -459,7 +442,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-string", fe.Field(), c)
+					t, err = ut.T("lt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -474,13 +457,13 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-items", fe.Field(), c)
+					t, err = ut.T("lt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
 						err = fmt.Errorf("tag '%s'不能用於struct類型.", fe.Tag())
 					} else {
-						t, err = ut.T("lt-datetime", fe.Field())
+						t, err = ut.T("lt-datetime", validator.FieldLabel(fe, ut))
 					}
 
 				default:
@@ -489,7 +472,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -546,11 +529,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -575,7 +554,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-string", fe.Field(), c)
+					t, err = ut.T("lte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -590,13 +569,13 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-items", fe.Field(), c)
+					t, err = ut.T("lte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
 						err = fmt.Errorf("tag '%s'不能用於struct類型.", fe.Tag())
 					} else {
-						t, err = ut.T("lte-datetime", fe.Field())
+						t, err = ut.T("lte-datetime", validator.FieldLabel(fe, ut))
 					}
 
 				default:
@@ -605,7 +584,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -662,10 +641,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 					return
 				}
 
@@ -689,7 +665,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-string", fe.Field(), c)
+					t, err = ut.T("gt-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -704,13 +680,13 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-items", fe.Field(), c)
+					t, err = ut.T("gt-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
 						err = fmt.Errorf("tag '%s'不能用於struct類型.", fe.Tag())
 					} else {
-						t, err = ut.T("gt-datetime", fe.Field())
+						t, err = ut.T("gt-datetime", validator.FieldLabel(fe, ut))
 					}
 
 				default:
@@ -719,7 +695,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -776,11 +752,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var kind reflect.Kind
 
 				fn := func() (err error) {
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = msgfmt.Number(fe)
 
 					return
 				}
@@ -805,7 +777,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-string", fe.Field(), c)
+					t, err = ut.T("gte-string", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
@@ -820,13 +792,13 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-items", fe.Field(), c)
+					t, err = ut.T("gte-items", validator.FieldLabel(fe, ut), c)
 
 				case reflect.Struct:
 					if fe.Type() != reflect.TypeOf(time.Time{}) {
 						err = fmt.Errorf("tag '%s'不能用於struct類型.", fe.Tag())
 					} else {
-						t, err = ut.T("gte-datetime", fe.Field())
+						t, err = ut.T("gte-datetime", validator.FieldLabel(fe, ut))
 					}
 
 				default:
@@ -835,7 +807,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fe.Field(), ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", validator.FieldLabel(fe, ut), msgfmt.Param(ut, fe))
 				}
 
 			END:
@@ -852,7 +824,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -866,7 +838,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -880,7 +852,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}不能等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -894,7 +866,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須大於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -908,7 +880,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須大於或等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -922,7 +894,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須小於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -936,7 +908,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須小於或等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -950,7 +922,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}不能等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -964,7 +936,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須大於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -978,7 +950,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須大於或等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -992,7 +964,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須小於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1006,7 +978,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須小於或等於{1}",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1090,7 +1062,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須包含文字'{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1104,7 +1076,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須包含至少一個以下字元'{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1118,7 +1090,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}不能包含文字'{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1132,7 +1104,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}不能包含以下任何字元'{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1146,7 +1118,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}不能包含'{1}'",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1330,7 +1302,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}必須是[{1}]中的一個",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				s, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				s, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1343,7 +1315,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0}與{1}格式不匹配",
 			override:    false,
 			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 				if err != nil {
 					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 					return fe.(error).Error()
@@ -1362,6 +1334,485 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 			translation: "{0} 必須是有效MIME類型",
 			override:    false,
 		},
+		{
+			tag:         "skip_unless",
+			translation: "{0}為必填欄位",
+			override:    false,
+		},
+		{
+			tag:             "eq_ignore_case",
+			translation:     "{0}必須等於{1}（忽略大小寫）",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "ne_ignore_case",
+			translation:     "{0}不能等於{1}（忽略大小寫）",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldcontains",
+			translation:     "{0}必須包含{1}的值",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "fieldexcludes",
+			translation:     "{0}不能包含{1}的值",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "alphaspace",
+			translation: "{0}只能包含字母和空格",
+			override:    false,
+		},
+		{
+			tag:         "alphanumspace",
+			translation: "{0}只能包含字母、數字和空格",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0}只能包含字母和Unicode字元",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0}只能包含字母數字和Unicode字元",
+			override:    false,
+		},
+		{
+			tag:         "cmyk",
+			translation: "{0}必須是一個有效的CMYK顏色",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0}必須是一個有效的E.164格式的電話號碼",
+			override:    false,
+		},
+		{
+			tag:         "base32",
+			translation: "{0}必須是一個有效的Base32字元串",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0}必須是一個有效的Base64 URL字元串",
+			override:    false,
+		},
+		{
+			tag:         "base64rawurl",
+			translation: "{0}必須是一個有效的無填充的Base64 URL字元串",
+			override:    false,
+		},
+		{
+			tag:             "containsrune",
+			translation:     "{0}必須包含字元'{1}'",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startswith",
+			translation:     "{0}必須以文字'{1}'開頭",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endswith",
+			translation:     "{0}必須以文字'{1}'結尾",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "startsnotwith",
+			translation:     "{0}不能以文字'{1}'開頭",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "endsnotwith",
+			translation:     "{0}不能以文字'{1}'結尾",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0}必須是一個有效的RFC 2141 URN",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc8141",
+			translation: "{0}必須是一個有效的RFC 8141 URN",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0}必須是一個有效的RFC 4122 UUID",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0}必須是一個有效的V3 RFC 4122 UUID",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0}必須是一個有效的V4 RFC 4122 UUID",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0}必須是一個有效的V5 RFC 4122 UUID",
+			override:    false,
+		},
+		{
+			tag:         "md4",
+			translation: "{0}必須是一個有效的MD4雜湊",
+			override:    false,
+		},
+		{
+			tag:         "md5",
+			translation: "{0}必須是一個有效的MD5雜湊",
+			override:    false,
+		},
+		{
+			tag:         "sha256",
+			translation: "{0}必須是一個有效的SHA256雜湊",
+			override:    false,
+		},
+		{
+			tag:         "sha384",
+			translation: "{0}必須是一個有效的SHA384雜湊",
+			override:    false,
+		},
+		{
+			tag:         "sha512",
+			translation: "{0}必須是一個有效的SHA512雜湊",
+			override:    false,
+		},
+		{
+			tag:         "ripemd128",
+			translation: "{0}必須是一個有效的RIPEMD-128雜湊",
+			override:    false,
+		},
+		{
+			tag:         "ripemd160",
+			translation: "{0}必須是一個有效的RIPEMD-160雜湊",
+			override:    false,
+		},
+		{
+			tag:         "tiger128",
+			translation: "{0}必須是一個有效的TIGER128雜湊",
+			override:    false,
+		},
+		{
+			tag:         "tiger160",
+			translation: "{0}必須是一個有效的TIGER160雜湊",
+			override:    false,
+		},
+		{
+			tag:         "tiger192",
+			translation: "{0}必須是一個有效的TIGER192雜湊",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0}必須是一個有效的比特幣地址",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0}必須是一個有效的Bech32比特幣地址",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0}必須是一個有效的以太坊地址",
+			override:    false,
+		},
+		{
+			tag:         "eth_addr_checksum",
+			translation: "{0}必須是一個有效的帶校驗和的以太坊地址",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0}必須是一個有效的主機名稱",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0}必須是一個有效的RFC 1123主機名稱",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0}必須是一個有效的主機名稱和連接埠",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0}必須是一個有效的FQDN",
+			override:    false,
+		},
+		{
+			tag:         "port",
+			translation: "{0}必須是一個有效的連接埠號碼",
+			override:    false,
+		},
+		{
+			tag:         "dns_rfc1035_label",
+			translation: "{0}必須是一個有效的RFC 1035 DNS標籤",
+			override:    false,
+		},
+		{
+			tag:         "http_url",
+			translation: "{0}必須是一個有效的HTTP URL",
+			override:    false,
+		},
+		{
+			tag:         "https_url",
+			translation: "{0}必須是一個有效的HTTPS URL",
+			override:    false,
+		},
+		{
+			tag:         "origin",
+			translation: "{0}必須是一個有效的來源（origin）",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0}必須是URL編碼的",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0}必須包含HTML標籤",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0}必須是HTML編碼的",
+			override:    false,
+		},
+		{
+			tag:         "uds_exists",
+			translation: "{0}必須是一個存在的Unix域通訊端",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0}必須是一個存在的目錄",
+			override:    false,
+		},
+		{
+			tag:         "dirpath",
+			translation: "{0}必須是一個有效的目錄路徑",
+			override:    false,
+		},
+		{
+			tag:         "file",
+			translation: "{0}必須是一個存在的檔案",
+			override:    false,
+		},
+		{
+			tag:         "filepath",
+			translation: "{0}必須是一個有效的檔案路徑",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0}必須包含唯一值",
+			override:    false,
+		},
+		{
+			tag:         "cron",
+			translation: "{0}必須是一個有效的cron表達式",
+			override:    false,
+		},
+		{
+			tag:             "noneof",
+			translation:     "{0}不能是[{1}]中的一個",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "oneofci",
+			translation:     "{0}必須是[{1}]中的一個（忽略大小寫）",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "noneofci",
+			translation:     "{0}不能是[{1}]中的一個（忽略大小寫）",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "json",
+			translation: "{0}必須是一個有效的JSON字串",
+			override:    false,
+		},
+		{
+			tag:         "jwt",
+			translation: "{0}必須是一個有效的JWT",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0}必須是小寫字母",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0}必須是大寫字母",
+			override:    false,
+		},
+		{
+			tag:         "boolean",
+			translation: "{0}必須是一個有效的布林值",
+			override:    false,
+		},
+		{
+			tag:         "timezone",
+			translation: "{0}必須是一個有效的時區",
+			override:    false,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2",
+			translation:     "{0}必須是一個有效的{1}國家郵遞區號",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:             "postcode_iso3166_alpha2_field",
+			translation:     "{0}必須是一個有效的{1}欄位所指國家的郵遞區號",
+			override:        false,
+			customTransFunc: translateFuncWithParam,
+		},
+		{
+			tag:         "country_code",
+			translation: "{0}必須是一個有效的國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "eu_country_code",
+			translation: "{0}必須是一個有效的歐盟國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0}必須是一個有效的ISO 3166-1 alpha-2國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2_eu",
+			translation: "{0}必須是一個有效的ISO 3166-1 alpha-2歐盟國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0}必須是一個有效的ISO 3166-1 alpha-3國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3_eu",
+			translation: "{0}必須是一個有效的ISO 3166-1 alpha-3歐盟國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0}必須是一個有效的ISO 3166-1數字國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric_eu",
+			translation: "{0}必須是一個有效的ISO 3166-1數字歐盟國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0}必須是一個有效的ISO 3166-2地區代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso4217",
+			translation: "{0}必須是一個有效的ISO 4217貨幣代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso4217_numeric",
+			translation: "{0}必須是一個有效的ISO 4217數字貨幣代碼",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0}必須是一個有效的BCP 47語言標籤",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_strict_language_tag",
+			translation: "{0}必須是一個有效的BCP 47語言標籤",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0}必須是一個有效的BIC代碼",
+			override:    false,
+		},
+		{
+			tag:         "bic_iso_9362_2014",
+			translation: "{0}必須是一個有效的ISO 9362:2014 BIC代碼",
+			override:    false,
+		},
+		{
+			tag:         "semver",
+			translation: "{0}必須是一個有效的語意化版本",
+			override:    false,
+		},
+		{
+			tag:         "cve",
+			translation: "{0}必須是一個有效的CVE識別碼",
+			override:    false,
+		},
+		{
+			tag:         "credit_card",
+			translation: "{0}必須是一個有效的信用卡號碼",
+			override:    false,
+		},
+		{
+			tag:         "luhn_checksum",
+			translation: "{0}必須是一個有效的Luhn校驗和",
+			override:    false,
+		},
+		{
+			tag:         "ein",
+			translation: "{0}必須是一個有效的EIN號碼",
+			override:    false,
+		},
+		{
+			tag:         "mongodb",
+			translation: "{0}必須是一個有效的MongoDB ObjectID",
+			override:    false,
+		},
+		{
+			tag:         "mongodb_connection_string",
+			translation: "{0}必須是一個有效的MongoDB連線字串",
+			override:    false,
+		},
+		{
+			tag:         "spicedb",
+			translation: "{0}必須是一個有效的SpiceDB識別碼",
+			override:    false,
+		},
+		{
+			tag:         "validateFn",
+			translation: "{0}必須是一個有效的物件",
+			override:    false,
+		},
 	}
 
 	for _, t := range translations {
@@ -1394,7 +1845,17 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 }
 
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut))
+	if err != nil {
+		log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+		return fe.(error).Error()
+	}
+
+	return t
+}
+
+func translateFuncWithParam(ut ut.Translator, fe validator.FieldError) string {
+	t, err := ut.T(fe.Tag(), validator.FieldLabel(fe, ut), fe.Param())
 	if err != nil {
 		log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
 		return fe.(error).Error()
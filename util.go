@@ -6,7 +6,6 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
 )
 
 // Valuer is an interface that allows you to expose a method on a type
@@ -63,7 +62,8 @@ BEGIN:
 
 	default:
 
-		if current.CanInterface() {
+		// checking the type first avoids allocating to box values which are not a Valuer
+		if current.CanInterface() && current.Type().Implements(valuerType) {
 			if v, ok := current.Interface().(Valuer); ok {
 				current = reflect.ValueOf(v.ValidatorValue())
 				goto BEGIN
@@ -256,28 +256,6 @@ func asInt(param string) int64 {
 	return i
 }
 
-// asIntFromTimeDuration parses param as time.Duration and returns it as int64
-// or panics on error.
-func asIntFromTimeDuration(param string) int64 {
-	d, err := time.ParseDuration(param)
-	if err != nil {
-		// attempt parsing as an integer assuming nanosecond precision
-		return asInt(param)
-	}
-	return int64(d)
-}
-
-// asIntFromType calls the proper function to parse param as int64,
-// given a field's Type t.
-func asIntFromType(t reflect.Type, param string) int64 {
-	switch t {
-	case timeDurationType:
-		return asIntFromTimeDuration(param)
-	default:
-		return asInt(param)
-	}
-}
-
 // asUint returns the parameter as a uint64
 // or panics if it can't convert
 func asUint(param string) uint64 {
@@ -303,15 +281,6 @@ func asFloat32(param string) float64 {
 	return i
 }
 
-// asBool returns the parameter as a bool
-// or panics if it can't convert
-func asBool(param string) bool {
-	i, err := strconv.ParseBool(param)
-	panicIf(err)
-
-	return i
-}
-
 func panicIf(err error) {
 	if err != nil {
 		panic(err.Error())
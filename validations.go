@@ -0,0 +1,597 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	invalidParam     = "Invalid parameter '%s' for validation '%s' on field '%s': %s"
+	invalidFieldKind = "Validation '%s' cannot be used on field '%s' of kind %s"
+)
+
+// ParamKind is the kind of parameter a validation accepts.
+type ParamKind uint8
+
+// Parameter kinds
+const (
+	// ParamUnchecked accepts any parameter, or none, and is used for validations
+	// registered without a ParamSchema.
+	ParamUnchecked ParamKind = iota
+
+	// ParamNone accepts no parameter.
+	ParamNone
+
+	// ParamString accepts any string.
+	ParamString
+
+	// ParamInt, ParamUint and ParamFloat accept numbers parsable by strconv,
+	// integers in any base prefix.
+	ParamInt
+	ParamUint
+	ParamFloat
+
+	// ParamNumber accepts a length for strings, slices, arrays and maps, a
+	// duration or integer for time.Duration and otherwise a number of the
+	// field's kind. Parameters on time.Time fields are not checked.
+	ParamNumber
+
+	// ParamValue accepts a value of the field's kind, or a length for slices,
+	// arrays and maps.
+	ParamValue
+
+	// ParamList accepts a space separated list of values of the field's kind,
+	// values containing spaces enclosed in single quotes.
+	ParamList
+
+	// ParamField accepts the name of another field.
+	ParamField
+)
+
+var paramKindNames = [...]string{
+	ParamUnchecked: "unchecked",
+	ParamNone:      "none",
+	ParamString:    "string",
+	ParamInt:       "int",
+	ParamUint:      "uint",
+	ParamFloat:     "float",
+	ParamNumber:    "number",
+	ParamValue:     "value",
+	ParamList:      "list",
+	ParamField:     "field",
+}
+
+func (k ParamKind) String() string {
+	if int(k) < len(paramKindNames) {
+		return paramKindNames[k]
+	}
+	return "unknown"
+}
+
+// ParamSchema describes the parameter of a validation.
+type ParamSchema struct {
+	Kind ParamKind
+
+	// Optional is true when the parameter may be omitted.
+	Optional bool
+
+	// Parse, when set, checks the parameter for a field of type typ in place of Kind.
+	Parse func(param string, typ reflect.Type) error
+}
+
+// ValidationInfo describes a registered validation.
+type ValidationInfo struct {
+	Tag         string
+	Description string
+
+	// Kinds are the kinds of field the validation can be used on, any when empty.
+	Kinds []reflect.Kind
+
+	Param          ParamSchema
+	CallEvenIfNull bool
+	BakedIn        bool
+}
+
+var (
+	integerKinds = []reflect.Kind{
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+	}
+	numberKinds     = append(append(integerKinds[:len(integerKinds):len(integerKinds)], reflect.Uintptr), reflect.Float32, reflect.Float64)
+	stringKinds     = []reflect.Kind{reflect.String}
+	collectionKinds = []reflect.Kind{reflect.Slice, reflect.Array, reflect.Map}
+	jsonKinds       = []reflect.Kind{reflect.String, reflect.Slice}
+	oneOfKinds      = append([]reflect.Kind{reflect.String}, integerKinds...)
+	coordinateKinds = append([]reflect.Kind{reflect.String, reflect.Float32, reflect.Float64}, integerKinds...)
+	lengthKinds     = append(append([]reflect.Kind{reflect.String}, collectionKinds...), numberKinds...)
+	equalKinds      = append([]reflect.Kind{reflect.Bool}, lengthKinds...)
+	compareKinds    = append([]reflect.Kind{reflect.Struct}, lengthKinds...)
+)
+
+// bakedInValidationInfo holds the metadata of the baked in validations.
+var bakedInValidationInfo = map[string]ValidationInfo{
+	"required":                      {Description: "Required", Param: ParamSchema{Kind: ParamNone}},
+	"required_if":                   {Description: "Required If", Param: ParamSchema{Kind: ParamString}},
+	"required_unless":               {Description: "Required Unless", Param: ParamSchema{Kind: ParamString}},
+	"skip_unless":                   {Description: "Skip Unless", Param: ParamSchema{Kind: ParamString}},
+	"required_with":                 {Description: "Required With", Param: ParamSchema{Kind: ParamString}},
+	"required_with_all":             {Description: "Required With All", Param: ParamSchema{Kind: ParamString}},
+	"required_without":              {Description: "Required Without", Param: ParamSchema{Kind: ParamString}},
+	"required_without_all":          {Description: "Required Without All", Param: ParamSchema{Kind: ParamString}},
+	"excluded_if":                   {Description: "Excluded If", Param: ParamSchema{Kind: ParamString}},
+	"excluded_unless":               {Description: "Excluded Unless", Param: ParamSchema{Kind: ParamString}},
+	"excluded_with":                 {Description: "Excluded With", Param: ParamSchema{Kind: ParamString}},
+	"excluded_with_all":             {Description: "Excluded With All", Param: ParamSchema{Kind: ParamString}},
+	"excluded_without":              {Description: "Excluded Without", Param: ParamSchema{Kind: ParamString}},
+	"excluded_without_all":          {Description: "Excluded Without All", Param: ParamSchema{Kind: ParamString}},
+	"isdefault":                     {Description: "Is Default", Param: ParamSchema{Kind: ParamNone}},
+	"len":                           {Description: "Length", Kinds: lengthKinds, Param: ParamSchema{Kind: ParamNumber}},
+	"min":                           {Description: "Minimum", Kinds: compareKinds, Param: ParamSchema{Kind: ParamNumber}},
+	"max":                           {Description: "Maximum", Kinds: compareKinds, Param: ParamSchema{Kind: ParamNumber}},
+	"eq":                            {Description: "Equals", Kinds: equalKinds, Param: ParamSchema{Kind: ParamValue}},
+	"eq_ignore_case":                {Description: "Equals ignoring case", Kinds: stringKinds, Param: ParamSchema{Kind: ParamString}},
+	"ne":                            {Description: "Not Equal", Kinds: equalKinds, Param: ParamSchema{Kind: ParamValue}},
+	"ne_ignore_case":                {Description: "Not Equal ignoring case", Kinds: stringKinds, Param: ParamSchema{Kind: ParamString}},
+	"lt":                            {Description: "Less Than", Kinds: compareKinds, Param: ParamSchema{Kind: ParamNumber}},
+	"lte":                           {Description: "Less Than or Equal", Kinds: compareKinds, Param: ParamSchema{Kind: ParamNumber}},
+	"gt":                            {Description: "Greater than", Kinds: compareKinds, Param: ParamSchema{Kind: ParamNumber}},
+	"gte":                           {Description: "Greater than or equal", Kinds: compareKinds, Param: ParamSchema{Kind: ParamNumber}},
+	"eqfield":                       {Description: "Field Equals Another Field", Param: ParamSchema{Kind: ParamField}},
+	"eqcsfield":                     {Description: "Field Equals Another Field (relative)", Param: ParamSchema{Kind: ParamField}},
+	"necsfield":                     {Description: "Field Does Not Equal Another Field (relative)", Param: ParamSchema{Kind: ParamField}},
+	"gtcsfield":                     {Description: "Field Greater Than Another Relative Field", Param: ParamSchema{Kind: ParamField}},
+	"gtecsfield":                    {Description: "Field Greater Than or Equal To Another Relative Field", Param: ParamSchema{Kind: ParamField}},
+	"ltcsfield":                     {Description: "Less Than Another Relative Field", Param: ParamSchema{Kind: ParamField}},
+	"ltecsfield":                    {Description: "Less Than or Equal To Another Relative Field", Param: ParamSchema{Kind: ParamField}},
+	"nefield":                       {Description: "Field Does Not Equal Another Field", Param: ParamSchema{Kind: ParamField}},
+	"gtefield":                      {Description: "Field Greater Than or Equal To Another Field", Param: ParamSchema{Kind: ParamField}},
+	"gtfield":                       {Description: "Field Greater Than Another Field", Param: ParamSchema{Kind: ParamField}},
+	"ltefield":                      {Description: "Less Than or Equal To Another Field", Param: ParamSchema{Kind: ParamField}},
+	"ltfield":                       {Description: "Less Than Another Field", Param: ParamSchema{Kind: ParamField}},
+	"fieldcontains":                 {Description: "Check the indicated characters are present in the Field", Param: ParamSchema{Kind: ParamField}},
+	"fieldexcludes":                 {Description: "Check the indicated characters are not present in the field", Param: ParamSchema{Kind: ParamField}},
+	"alpha":                         {Description: "Alpha Only", Param: ParamSchema{Kind: ParamNone}},
+	"alphaspace":                    {Description: "Alpha Space", Param: ParamSchema{Kind: ParamNone}},
+	"alphanum":                      {Description: "Alphanumeric", Param: ParamSchema{Kind: ParamNone}},
+	"alphanumspace":                 {Description: "Alphanumeric Space", Param: ParamSchema{Kind: ParamNone}},
+	"alphaunicode":                  {Description: "Alpha Unicode", Param: ParamSchema{Kind: ParamNone}},
+	"alphanumunicode":               {Description: "Alphanumeric Unicode", Param: ParamSchema{Kind: ParamNone}},
+	"boolean":                       {Description: "Boolean", Param: ParamSchema{Kind: ParamNone}},
+	"numeric":                       {Description: "Numeric", Param: ParamSchema{Kind: ParamNone}},
+	"number":                        {Description: "Number", Param: ParamSchema{Kind: ParamNone}},
+	"hexadecimal":                   {Description: "Hexadecimal String", Param: ParamSchema{Kind: ParamNone}},
+	"hexcolor":                      {Description: "Hexcolor String", Param: ParamSchema{Kind: ParamNone}},
+	"rgb":                           {Description: "RGB String", Param: ParamSchema{Kind: ParamNone}},
+	"rgba":                          {Description: "RGBA String", Param: ParamSchema{Kind: ParamNone}},
+	"hsl":                           {Description: "HSL String", Param: ParamSchema{Kind: ParamNone}},
+	"hsla":                          {Description: "HSLA String", Param: ParamSchema{Kind: ParamNone}},
+	"cmyk":                          {Description: "CMYK String", Param: ParamSchema{Kind: ParamNone}},
+	"e164":                          {Description: "e164 formatted phone number", Param: ParamSchema{Kind: ParamNone}},
+	"email":                         {Description: "E-mail String", Param: ParamSchema{Kind: ParamNone}},
+	"url":                           {Description: "URL String", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"http_url":                      {Description: "HTTP(s) URL String", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"https_url":                     {Description: "HTTPS-only URL String", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"uri":                           {Description: "URI String", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"origin":                        {Description: "Web origin (URL with HTTP(S) scheme and host, but no path/query/fragment)", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"urn_rfc8141":                   {Description: "Urn RFC 8141 String", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"urn_rfc2141":                   {Description: "Urn RFC 2141 String", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"file":                          {Description: "Existing File", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"filepath":                      {Description: "File Path", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"base32":                        {Description: "Base32 String", Param: ParamSchema{Kind: ParamNone}},
+	"base64":                        {Description: "Base64 String", Param: ParamSchema{Kind: ParamNone}},
+	"base64url":                     {Description: "Base64URL String", Param: ParamSchema{Kind: ParamNone}},
+	"base64rawurl":                  {Description: "Base64RawURL String", Param: ParamSchema{Kind: ParamNone}},
+	"contains":                      {Description: "Contains", Param: ParamSchema{Kind: ParamString}},
+	"containsany":                   {Description: "Contains Any", Param: ParamSchema{Kind: ParamString}},
+	"containsrune":                  {Description: "Contains Rune", Param: ParamSchema{Kind: ParamString}},
+	"excludes":                      {Description: "Excludes", Param: ParamSchema{Kind: ParamString}},
+	"excludesall":                   {Description: "Excludes All", Param: ParamSchema{Kind: ParamString}},
+	"excludesrune":                  {Description: "Excludes Rune", Param: ParamSchema{Kind: ParamString}},
+	"startswith":                    {Description: "Starts With", Param: ParamSchema{Kind: ParamString}},
+	"endswith":                      {Description: "Ends With", Param: ParamSchema{Kind: ParamString}},
+	"startsnotwith":                 {Description: "Starts Not With", Param: ParamSchema{Kind: ParamString}},
+	"endsnotwith":                   {Description: "Ends Not With", Param: ParamSchema{Kind: ParamString}},
+	"image":                         {Description: "Image", Param: ParamSchema{Kind: ParamNone}},
+	"mimetype":                      {Description: "MIME Type", Param: ParamSchema{Kind: ParamString}},
+	"isbn":                          {Description: "International Standard Book Number", Param: ParamSchema{Kind: ParamNone}},
+	"isbn10":                        {Description: "International Standard Book Number 10", Param: ParamSchema{Kind: ParamNone}},
+	"isbn13":                        {Description: "International Standard Book Number 13", Param: ParamSchema{Kind: ParamNone}},
+	"issn":                          {Description: "International Standard Serial Number", Param: ParamSchema{Kind: ParamNone}},
+	"eth_addr":                      {Description: "Ethereum Address", Param: ParamSchema{Kind: ParamNone}},
+	"eth_addr_checksum":             {Description: "Ethereum Address with Checksum", Param: ParamSchema{Kind: ParamNone}},
+	"btc_addr":                      {Description: "Bitcoin Address", Param: ParamSchema{Kind: ParamNone}},
+	"btc_addr_bech32":               {Description: "Bitcoin Bech32 Address (segwit)", Param: ParamSchema{Kind: ParamNone}},
+	"uuid":                          {Description: "Universally Unique Identifier UUID", Param: ParamSchema{Kind: ParamNone}},
+	"uuid3":                         {Description: "Universally Unique Identifier UUID v3", Param: ParamSchema{Kind: ParamNone}},
+	"uuid4":                         {Description: "Universally Unique Identifier UUID v4", Param: ParamSchema{Kind: ParamNone}},
+	"uuid5":                         {Description: "Universally Unique Identifier UUID v5", Param: ParamSchema{Kind: ParamNone}},
+	"uuid_rfc4122":                  {Description: "Universally Unique Identifier UUID RFC4122", Param: ParamSchema{Kind: ParamNone}},
+	"uuid3_rfc4122":                 {Description: "Universally Unique Identifier UUID v3 RFC4122", Param: ParamSchema{Kind: ParamNone}},
+	"uuid4_rfc4122":                 {Description: "Universally Unique Identifier UUID v4 RFC4122", Param: ParamSchema{Kind: ParamNone}},
+	"uuid5_rfc4122":                 {Description: "Universally Unique Identifier UUID v5 RFC4122", Param: ParamSchema{Kind: ParamNone}},
+	"ulid":                          {Description: "Universally Unique Lexicographically Sortable Identifier ULID", Param: ParamSchema{Kind: ParamNone}},
+	"md4":                           {Description: "MD4 hash", Param: ParamSchema{Kind: ParamNone}},
+	"md5":                           {Description: "MD5 hash", Param: ParamSchema{Kind: ParamNone}},
+	"sha256":                        {Description: "SHA256 hash", Param: ParamSchema{Kind: ParamNone}},
+	"sha384":                        {Description: "SHA384 hash", Param: ParamSchema{Kind: ParamNone}},
+	"sha512":                        {Description: "SHA512 hash", Param: ParamSchema{Kind: ParamNone}},
+	"ripemd128":                     {Description: "RIPEMD-128 hash", Param: ParamSchema{Kind: ParamNone}},
+	"ripemd160":                     {Description: "RIPEMD-160 hash", Param: ParamSchema{Kind: ParamNone}},
+	"tiger128":                      {Description: "TIGER128 hash", Param: ParamSchema{Kind: ParamNone}},
+	"tiger160":                      {Description: "TIGER160 hash", Param: ParamSchema{Kind: ParamNone}},
+	"tiger192":                      {Description: "TIGER192 hash", Param: ParamSchema{Kind: ParamNone}},
+	"ascii":                         {Description: "ASCII", Param: ParamSchema{Kind: ParamNone}},
+	"printascii":                    {Description: "Printable ASCII", Param: ParamSchema{Kind: ParamNone}},
+	"multibyte":                     {Description: "Multi-Byte Characters", Param: ParamSchema{Kind: ParamNone}},
+	"datauri":                       {Description: "Data URL", Param: ParamSchema{Kind: ParamNone}},
+	"latitude":                      {Description: "Latitude", Kinds: coordinateKinds, Param: ParamSchema{Kind: ParamNone}},
+	"longitude":                     {Description: "Longitude", Kinds: coordinateKinds, Param: ParamSchema{Kind: ParamNone}},
+	"ssn":                           {Description: "Social Security Number SSN", Param: ParamSchema{Kind: ParamNone}},
+	"ipv4":                          {Description: "Internet Protocol Address IPv4", Param: ParamSchema{Kind: ParamNone}},
+	"ipv6":                          {Description: "Internet Protocol Address IPv6", Param: ParamSchema{Kind: ParamNone}},
+	"ip":                            {Description: "Internet Protocol Address IP", Param: ParamSchema{Kind: ParamNone}},
+	"cidrv4":                        {Description: "Classless Inter-Domain Routing CIDRv4", Param: ParamSchema{Kind: ParamNone}},
+	"cidrv6":                        {Description: "Classless Inter-Domain Routing CIDRv6", Param: ParamSchema{Kind: ParamNone}},
+	"cidr":                          {Description: "Classless Inter-Domain Routing CIDR", Param: ParamSchema{Kind: ParamNone}},
+	"tcp4_addr":                     {Description: "Transmission Control Protocol Address TCPv4", Param: ParamSchema{Kind: ParamNone}},
+	"tcp6_addr":                     {Description: "Transmission Control Protocol Address TCPv6", Param: ParamSchema{Kind: ParamNone}},
+	"tcp_addr":                      {Description: "Transmission Control Protocol Address TCP", Param: ParamSchema{Kind: ParamNone}},
+	"udp4_addr":                     {Description: "User Datagram Protocol Address UDPv4", Param: ParamSchema{Kind: ParamNone}},
+	"udp6_addr":                     {Description: "User Datagram Protocol Address UDPv6", Param: ParamSchema{Kind: ParamNone}},
+	"udp_addr":                      {Description: "User Datagram Protocol Address UDP", Param: ParamSchema{Kind: ParamNone}},
+	"ip4_addr":                      {Description: "Internet Protocol Address IPv4", Param: ParamSchema{Kind: ParamNone}},
+	"ip6_addr":                      {Description: "Internet Protocol Address IPv6", Param: ParamSchema{Kind: ParamNone}},
+	"ip_addr":                       {Description: "Internet Protocol Address IP", Param: ParamSchema{Kind: ParamNone}},
+	"unix_addr":                     {Description: "Unix domain socket end point Address", Param: ParamSchema{Kind: ParamNone}},
+	"uds_exists":                    {Description: "Unix domain socket exists (checks filesystem sockets and Linux abstract sockets)", Param: ParamSchema{Kind: ParamNone}},
+	"mac":                           {Description: "Media Access Control Address MAC", Param: ParamSchema{Kind: ParamNone}},
+	"hostname":                      {Description: "Hostname RFC 952", Param: ParamSchema{Kind: ParamNone}},
+	"hostname_rfc1123":              {Description: "Hostname RFC 1123", Param: ParamSchema{Kind: ParamNone}},
+	"fqdn":                          {Description: "Full Qualified Domain Name (FQDN)", Param: ParamSchema{Kind: ParamNone}},
+	"unique":                        {Description: "Unique", Param: ParamSchema{Kind: ParamString, Optional: true}},
+	"oneof":                         {Description: "One Of", Kinds: oneOfKinds, Param: ParamSchema{Kind: ParamList}},
+	"oneofci":                       {Description: "One Of (case insensitive)", Kinds: stringKinds, Param: ParamSchema{Kind: ParamList}},
+	"noneof":                        {Description: "None Of", Kinds: oneOfKinds, Param: ParamSchema{Kind: ParamList}},
+	"noneofci":                      {Description: "None Of (case insensitive)", Kinds: stringKinds, Param: ParamSchema{Kind: ParamList}},
+	"html":                          {Description: "HTML Tags", Param: ParamSchema{Kind: ParamNone}},
+	"html_encoded":                  {Description: "HTML Encoded", Param: ParamSchema{Kind: ParamNone}},
+	"url_encoded":                   {Description: "URL Encoded", Param: ParamSchema{Kind: ParamNone}},
+	"dir":                           {Description: "Existing Directory", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"dirpath":                       {Description: "Directory Path", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"json":                          {Description: "JSON", Kinds: jsonKinds, Param: ParamSchema{Kind: ParamNone}},
+	"jwt":                           {Description: "JSON Web Token (JWT)", Param: ParamSchema{Kind: ParamNone}},
+	"hostname_port":                 {Description: "HostPort", Param: ParamSchema{Kind: ParamNone}},
+	"port":                          {Description: "Port number", Param: ParamSchema{Kind: ParamNone}},
+	"lowercase":                     {Description: "Lowercase", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"uppercase":                     {Description: "Uppercase", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"datetime":                      {Description: "Datetime", Kinds: stringKinds, Param: ParamSchema{Kind: ParamString}},
+	"timezone":                      {Description: "Timezone", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"iso3166_1_alpha2":              {Description: "Two-letter country code (ISO 3166-1 alpha-2)", Param: ParamSchema{Kind: ParamNone}},
+	"iso3166_1_alpha2_eu":           {Description: "EU Country Code ISO 3166-1 alpha-2", Param: ParamSchema{Kind: ParamNone}},
+	"iso3166_1_alpha3":              {Description: "Three-letter country code (ISO 3166-1 alpha-3)", Param: ParamSchema{Kind: ParamNone}},
+	"iso3166_1_alpha3_eu":           {Description: "EU Country Code ISO 3166-1 alpha-3", Param: ParamSchema{Kind: ParamNone}},
+	"iso3166_1_alpha_numeric":       {Description: "Numeric country code (ISO 3166-1 numeric)", Kinds: oneOfKinds, Param: ParamSchema{Kind: ParamNone}},
+	"iso3166_1_alpha_numeric_eu":    {Description: "EU Country Code ISO 3166-1 alpha-numeric", Kinds: oneOfKinds, Param: ParamSchema{Kind: ParamNone}},
+	"iso3166_2":                     {Description: "Country subdivision code (ISO 3166-2)", Param: ParamSchema{Kind: ParamNone}},
+	"iso4217":                       {Description: "Currency code (ISO 4217)", Param: ParamSchema{Kind: ParamNone}},
+	"iso4217_numeric":               {Description: "Currency Code ISO 4217 numeric", Kinds: integerKinds, Param: ParamSchema{Kind: ParamNone}},
+	"bcp47_language_tag":            {Description: "Language tag (BCP 47)", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"bcp47_strict_language_tag":     {Description: "Language tag (BCP 47), strictly following RFC 5646", Kinds: stringKinds, Param: ParamSchema{Kind: ParamNone}},
+	"postcode_iso3166_alpha2":       {Description: "Postcode", Param: ParamSchema{Kind: ParamString}},
+	"postcode_iso3166_alpha2_field": {Description: "Postcode", Kinds: stringKinds, Param: ParamSchema{Kind: ParamField}},
+	"bic_iso_9362_2014":             {Description: "Business Identifier Code (ISO 9362:2014)", Param: ParamSchema{Kind: ParamNone}},
+	"bic":                           {Description: "Business Identifier Code (ISO 9362:2022)", Param: ParamSchema{Kind: ParamNone}},
+	"semver":                        {Description: "Semantic Versioning 2.0.0", Param: ParamSchema{Kind: ParamNone}},
+	"dns_rfc1035_label":             {Description: "DNS Label RFC 1035", Param: ParamSchema{Kind: ParamNone}},
+	"credit_card":                   {Description: "Credit Card Number", Param: ParamSchema{Kind: ParamNone}},
+	"cve":                           {Description: "Common Vulnerabilities and Exposures Identifier (CVE id)", Param: ParamSchema{Kind: ParamNone}},
+	"luhn_checksum":                 {Description: "Luhn Algorithm Checksum (for strings and (u)int)", Kinds: oneOfKinds, Param: ParamSchema{Kind: ParamNone}},
+	"mongodb":                       {Description: "MongoDB ObjectID", Param: ParamSchema{Kind: ParamNone}},
+	"mongodb_connection_string":     {Description: "MongoDB Connection String", Param: ParamSchema{Kind: ParamNone}},
+	"cron":                          {Description: "Cron", Param: ParamSchema{Kind: ParamNone}},
+	"spicedb":                       {Description: "SpiceDb ObjectID/Permission/Type", Param: ParamSchema{Kind: ParamString, Optional: true}},
+	"ein":                           {Description: "U.S. Employer Identification Number", Param: ParamSchema{Kind: ParamNone}},
+	"validateFn":                    {Description: "Verify the method Validate() error, or the method named in its param, does not return an error", Param: ParamSchema{Kind: ParamString, Optional: true}},
+}
+
+// RegisterValidationWithInfo adds a validation with the given tag and the metadata
+// in info, which Validations exposes and strict tag checking uses.
+//
+// NOTES:
+// - if the key already exists, the previous validation function will be replaced.
+// - this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterValidationWithInfo(tag string, fn Func, info ValidationInfo) error {
+	return v.RegisterValidationWithInfoCtx(tag, wrapFunc(fn), info)
+}
+
+// RegisterValidationWithInfoCtx does the same as RegisterValidationWithInfo but accepts a FuncCtx validation
+// allowing context.Context validation support.
+func (v *Validate) RegisterValidationWithInfoCtx(tag string, fn FuncCtx, info ValidationInfo) error {
+	info.Tag = tag
+	info.BakedIn = false
+
+	if err := v.registerValidation(tag, fn, false, info.CallEvenIfNull); err != nil {
+		return err
+	}
+
+	wrapper := v.validations[tag]
+	wrapper.info = info
+	v.validations[tag] = wrapper
+	return nil
+}
+
+// Validations returns the metadata of every registered validation, baked in
+// and custom, sorted by tag.
+func (v *Validate) Validations() []ValidationInfo {
+	infos := make([]ValidationInfo, 0, len(v.validations))
+	for _, wrapper := range v.validations {
+		infos = append(infos, wrapper.info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Tag < infos[j].Tag
+	})
+	return infos
+}
+
+// checkTags panics if any of the tags in the chain whose parameter is checked has
+// an invalid parameter or, with WithStrictTags, is used on a field kind it does
+// not support, typ being the field's type and nil when it is not known eg.
+// within an interface.
+func (v *Validate) checkTags(ct *cTag, typ reflect.Type, fieldName string) {
+	for ; ct != nil; ct = ct.next {
+		switch ct.typeof {
+		case typeDive:
+			var keyTyp, elemTyp reflect.Type
+
+			if typ = v.checkedType(typ); typ != nil {
+				switch typ.Kind() {
+				case reflect.Map:
+					keyTyp = typ.Key()
+					elemTyp = typ.Elem()
+				case reflect.Slice, reflect.Array:
+					elemTyp = typ.Elem()
+				}
+			}
+
+			if ct.next != nil && ct.next.typeof == typeKeys {
+				ct = ct.next
+				v.checkTags(ct.keys, keyTyp, fieldName)
+			}
+
+			v.checkTags(ct.next, elemTyp, fieldName)
+			return
+
+		case typeDefault, typeOr, typeIsDefault:
+			if !ct.hasTag || ct.fn == nil {
+				continue
+			}
+
+			wrapper, ok := v.validations[ct.tag]
+			if !ok || !v.checksParam(wrapper.info) {
+				continue
+			}
+
+			ft := v.checkedType(typ)
+			if ft == nil {
+				ct.checkParam = true
+			}
+
+			if !wrapper.info.supports(ft) {
+				if !v.strictTags {
+					// the validation reports the unsupported kind itself
+					continue
+				}
+				panic(fmt.Sprintf(invalidFieldKind, ct.tag, fieldName, ft.Kind()))
+			}
+
+			if err := wrapper.info.checkParam(ct.param, ct.hasParam, ft); err != nil {
+				panic(fmt.Sprintf(invalidParam, ct.param, ct.tag, fieldName, err))
+			}
+		}
+	}
+}
+
+// deferParamChecks marks the tags in the chain whose parameter is checked, for
+// checking against the field's type when validating as it is not known when
+// the tags are cached eg. for Var.
+func (v *Validate) deferParamChecks(ct *cTag) {
+	for ; ct != nil; ct = ct.next {
+		if ct.keys != nil {
+			v.deferParamChecks(ct.keys)
+		}
+
+		if !ct.hasTag || ct.fn == nil {
+			continue
+		}
+
+		if wrapper, ok := v.validations[ct.tag]; ok && v.checksParam(wrapper.info) {
+			ct.checkParam = true
+		}
+	}
+}
+
+// checkDeferredParam panics if the parameter of ct, whose check was deferred
+// until validating, is not valid for a field of type typ, as the baked in
+// validations would when parsing it.
+func (v *validate) checkDeferredParam(ct *cTag, typ reflect.Type) {
+	if checked, _ := ct.paramTyp.Load().(reflect.Type); checked == typ {
+		return
+	}
+
+	info := v.v.validations[ct.tag].info
+	if !v.v.strictTags && !info.supports(typ) {
+		// the validation reports the unsupported kind itself
+		return
+	}
+
+	panicIf(info.checkParam(ct.param, ct.hasParam, typ))
+	ct.paramTyp.Store(typ)
+}
+
+// checkedType returns the type whose kind the validation will run against, nil
+// when that can't be known before validating.
+func (v *Validate) checkedType(typ reflect.Type) reflect.Type {
+	for typ != nil {
+		if typ.Kind() == reflect.Interface || typ.Implements(valuerType) {
+			return nil
+		}
+
+		if _, ok := v.customFuncs[typ]; ok {
+			return nil
+		}
+
+		if typ.Kind() != reflect.Ptr {
+			break
+		}
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// supports returns whether the validation can be used on a field of type typ,
+// typ being nil when it is not known.
+func (info ValidationInfo) supports(typ reflect.Type) bool {
+	if typ == nil || len(info.Kinds) == 0 {
+		return true
+	}
+
+	for _, k := range info.Kinds {
+		if typ.Kind() == k {
+			return true
+		}
+	}
+	return false
+}
+
+// checksParam returns whether the parameter of the validation is checked, those
+// of the baked in validations parsed as numbers or values always being checked
+// as they would otherwise fail when validating.
+func (v *Validate) checksParam(info ValidationInfo) bool {
+	if v.strictTags {
+		return info.Param.Kind != ParamUnchecked || info.Param.Parse != nil
+	}
+
+	if !info.BakedIn {
+		return false
+	}
+
+	switch info.Param.Kind {
+	case ParamInt, ParamUint, ParamFloat, ParamNumber, ParamValue:
+		return true
+	}
+	return false
+}
+
+// checkParam returns an error if param is not valid for a field of type typ,
+// typ being nil when it is not known.
+func (info ValidationInfo) checkParam(param string, hasParam bool, typ reflect.Type) error {
+	if info.Param.Parse != nil {
+		if typ == nil {
+			return nil
+		}
+		return info.Param.Parse(param, typ)
+	}
+
+	switch info.Param.Kind {
+	case ParamUnchecked:
+		return nil
+
+	case ParamNone:
+		if hasParam {
+			return fmt.Errorf("takes no parameter")
+		}
+		return nil
+	}
+
+	if info.Param.Kind == ParamNumber && (typ == nil || typ.Kind() == reflect.Struct) {
+		// time.Time compares against the current time, ignoring the parameter
+		return nil
+	}
+
+	if !hasParam {
+		if info.Param.Optional {
+			return nil
+		}
+		return fmt.Errorf("requires a parameter")
+	}
+
+	var err error
+
+	switch info.Param.Kind {
+	case ParamInt:
+		_, err = strconv.ParseInt(param, 0, 64)
+
+	case ParamUint:
+		_, err = strconv.ParseUint(param, 0, 64)
+
+	case ParamFloat:
+		_, err = strconv.ParseFloat(param, 64)
+
+	case ParamField:
+		if len(param) == 0 {
+			err = fmt.Errorf("requires a field name")
+		}
+
+	case ParamNumber:
+		err = parseValue(param, typ, false)
+
+	case ParamValue:
+		if typ != nil {
+			err = parseValue(param, typ, true)
+		}
+
+	case ParamList:
+		vals := splitParamsRegex().FindAllString(param, -1)
+		if len(vals) == 0 {
+			return fmt.Errorf("requires at least one value")
+		}
+
+		if typ != nil {
+			for _, val := range vals {
+				if err = parseValue(strings.ReplaceAll(val, "'", ""), typ, true); err != nil {
+					break
+				}
+			}
+		}
+	}
+	return err
+}
+
+// parseValue parses param as a number of the kind of typ, or a length for strings
+// and collections; when value is true strings and bools are parsed as values.
+func parseValue(param string, typ reflect.Type, value bool) error {
+	var err error
+
+	switch typ.Kind() {
+	case reflect.String:
+		if !value {
+			_, err = strconv.ParseInt(param, 0, 64)
+		}
+
+	case reflect.Slice, reflect.Array, reflect.Map:
+		_, err = strconv.ParseInt(param, 0, 64)
+
+	case reflect.Bool:
+		_, err = strconv.ParseBool(param)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if typ == timeDurationType {
+			if _, derr := time.ParseDuration(param); derr == nil {
+				return nil
+			}
+		}
+		_, err = strconv.ParseInt(param, 0, 64)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		_, err = strconv.ParseUint(param, 0, 64)
+
+	case reflect.Float32, reflect.Float64:
+		_, err = strconv.ParseFloat(param, typ.Bits())
+	}
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 	"unsafe"
 )
 
@@ -17,12 +18,23 @@ type validate struct {
 	errs           ValidationErrors
 	includeExclude map[string]struct{} // reset only if StructPartial or StructExcept are called, no need otherwise
 	ffn            FilterFunc
+	scope          scope         // restricts traversal, nil unless called from StructPresent or StructMask
 	slflParent     reflect.Value // StructLevel & FieldLevel
 	slCurrent      reflect.Value // StructLevel & FieldLevel
 	flField        reflect.Value // StructLevel & FieldLevel
 	cf             *cField       // StructLevel & FieldLevel
 	ct             *cTag         // StructLevel & FieldLevel
 	misc           []byte        // misc reusable
+	errBuf         []byte        // namespaces of errs, handed over with them
+	result         *Result       // storage for errs, nil unless called from StructInto
+	trace          *Trace        // nil unless called from ExplainCtx
+	seq            uint64        // number of fields visited, orders errors by field
+	fieldSeqs      []uint64      // seq of each field of the structs being traversed
+	slStruct       *cStruct      // StructLevel
+	slSeqs         []uint64      // StructLevel, seq of each field of slStruct
+	strVal         reflect.Value // reusable for VarString
+	intVal         reflect.Value // reusable for VarInt
+	floatVal       reflect.Value // reusable for VarFloat
 	str1           string        // misc reusable
 	str2           string        // misc reusable
 	fldIsPointer   bool          // StructLevel & FieldLevel
@@ -30,8 +42,26 @@ type validate struct {
 	hasExcludes    bool
 }
 
+// takeErrors returns the error of the validation run, handing over errs.
+func (v *validate) takeErrors() (err error) {
+	if len(v.errs) > 0 {
+		err = v.errs
+		v.errs = nil
+		v.errBuf = nil
+	}
+	return
+}
+
 // parent and current will be the same the first run of validateStruct
 func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, current reflect.Value, typ reflect.Type, ns []byte, structNs []byte, ct *cTag) {
+	var start time.Time
+	var errs int
+
+	if v.v.observer != nil {
+		start = time.Now()
+		errs = len(v.errs)
+	}
+
 	cs, ok := v.v.structCache.Get(typ)
 	if !ok {
 		cs = v.v.extractStructCache(current, typ.Name())
@@ -47,12 +77,17 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 
 	// ct is nil on top level struct, and structs as fields that have no tag info
 	// so if nil or if not nil and the structonly tag isn't present
+	base := len(v.fieldSeqs)
+
 	if ct == nil || ct.typeof != typeStructOnly {
 		var f *cField
 
 		for i := 0; i < len(cs.fields); i++ {
 			f = cs.fields[i]
 
+			// the field is visited next, its seq recorded to order struct level errors by field
+			v.fieldSeqs = append(v.fieldSeqs, v.seq+1)
+
 			if v.isPartial {
 				if v.ffn != nil {
 					// used with StructFiltered
@@ -69,6 +104,23 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 				}
 			}
 
+			if v.scope != nil {
+				sc := v.scope
+				child, ok := sc.field(f.name, f.altName)
+				if !ok {
+					if !f.anonymous {
+						continue
+					}
+					// embedded structs are flattened into their parent
+					child = sc
+				}
+
+				v.scope = child
+				v.traverseField(ctx, current, current.Field(f.idx), ns, structNs, f, f.cTags)
+				v.scope = sc
+				continue
+			}
+
 			v.traverseField(ctx, current, current.Field(f.idx), ns, structNs, f, f.cTags)
 		}
 	}
@@ -81,8 +133,29 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 		v.slCurrent = current
 		v.ns = ns
 		v.actualNs = structNs
+		v.slStruct = cs
+		v.slSeqs = v.fieldSeqs[base:]
+
+		if v.v.observer != nil || v.trace != nil {
+			v.observeStructLevel(ctx, cs, typ, ns)
+		} else {
+			cs.fn(ctx, v)
+		}
+
+		v.slStruct = nil
+		v.slSeqs = nil
+	}
+
+	v.fieldSeqs = v.fieldSeqs[:base]
 
-		cs.fn(ctx, v)
+	if v.v.observer != nil {
+		v.v.observer.ObserveStruct(ctx, StructEvent{
+			Type:      typ,
+			Namespace: namespaceString(ns, ""),
+			Start:     start,
+			Duration:  time.Since(start),
+			Errors:    len(v.errs) - errs,
+		})
 	}
 }
 
@@ -91,6 +164,12 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 	var typ reflect.Type
 	var kind reflect.Kind
 
+	v.seq++
+
+	if v.trace != nil {
+		v.traceField(ns, cf)
+	}
+
 	current, kind, v.fldIsPointer = v.extractTypeInternal(current, false)
 
 	var isNestedStruct bool
@@ -102,64 +181,52 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 			return
 		}
 
-		if ct.typeof == typeOmitEmpty || ct.typeof == typeIsDefault {
-			return
-		}
-
-		if ct.typeof == typeOmitNil && (kind != reflect.Invalid && current.IsNil()) {
-			return
-		}
-
-		if ct.typeof == typeOmitZero {
+		if ct.typeof == typeOmitEmpty || ct.typeof == typeIsDefault || ct.typeof == typeOmitZero ||
+			(ct.typeof == typeOmitNil && (kind != reflect.Invalid && current.IsNil())) {
+			if v.trace != nil {
+				v.traceSkip(ns, cf, ct)
+			}
 			return
 		}
 
 		if ct.hasTag {
 			if kind == reflect.Invalid {
-				v.str1 = appendAltName(ns, cf.altName)
-				if v.v.hasTagNameFunc {
-					v.str2 = string(append(structNs, cf.name...))
-				} else {
-					v.str2 = v.str1
-				}
-				v.errs = append(v.errs,
-					&fieldError{
-						v:              v.v,
-						tag:            ct.aliasTag,
-						actualTag:      ct.tag,
-						ns:             v.str1,
-						structNs:       v.str2,
-						fieldLen:       uint8(len(cf.altName)),
-						structfieldLen: uint8(len(cf.name)),
-						param:          ct.param,
-						kind:           kind,
-					},
-				)
+				v.str1, v.str2 = v.namespaces(ns, structNs, cf)
+				v.appendError(fieldError{
+					v:              v.v,
+					tag:            ct.aliasTag,
+					actualTag:      ct.tag,
+					ns:             v.str1,
+					structNs:       v.str2,
+					fieldLen:       uint8(len(cf.altName)),
+					structfieldLen: uint8(len(cf.name)),
+					param:          ct.param,
+					seq:            v.seq,
+					labels:         cf.labels,
+					sensitive:      ct.sensitive,
+					kind:           kind,
+				})
 				return
 			}
 
-			v.str1 = appendAltName(ns, cf.altName)
-			if v.v.hasTagNameFunc {
-				v.str2 = string(append(structNs, cf.name...))
-			} else {
-				v.str2 = v.str1
-			}
 			if !ct.runValidationWhenNil {
-				v.errs = append(v.errs,
-					&fieldError{
-						v:              v.v,
-						tag:            ct.aliasTag,
-						actualTag:      ct.tag,
-						ns:             v.str1,
-						structNs:       v.str2,
-						fieldLen:       uint8(len(cf.altName)),
-						structfieldLen: uint8(len(cf.name)),
-						value:          getValue(current),
-						param:          ct.param,
-						kind:           kind,
-						typ:            current.Type(),
-					},
-				)
+				v.str1, v.str2 = v.namespaces(ns, structNs, cf)
+				v.appendError(fieldError{
+					v:              v.v,
+					tag:            ct.aliasTag,
+					actualTag:      ct.tag,
+					ns:             v.str1,
+					structNs:       v.str2,
+					fieldLen:       uint8(len(cf.altName)),
+					structfieldLen: uint8(len(cf.name)),
+					value:          getValue(current),
+					param:          ct.param,
+					seq:            v.seq,
+					labels:         cf.labels,
+					sensitive:      ct.sensitive,
+					kind:           kind,
+					typ:            current.Type(),
+				})
 				return
 			}
 		}
@@ -233,6 +300,9 @@ OUTER:
 			v.ct = ct
 
 			if !hasValue(v) {
+				if v.trace != nil {
+					v.traceSkip(ns, cf, ct)
+				}
 				return
 			}
 
@@ -246,6 +316,9 @@ OUTER:
 			v.ct = ct
 
 			if !hasNotZeroValue(v) {
+				if v.trace != nil {
+					v.traceSkip(ns, cf, ct)
+				}
 				return
 			}
 
@@ -261,10 +334,16 @@ OUTER:
 			switch field := v.Field(); field.Kind() {
 			case reflect.Slice, reflect.Map, reflect.Ptr, reflect.Interface, reflect.Chan, reflect.Func:
 				if field.IsNil() {
+					if v.trace != nil {
+						v.traceSkip(ns, cf, ct)
+					}
 					return
 				}
 			default:
 				if v.fldIsPointer && getValue(field) == nil {
+					if v.trace != nil {
+						v.traceSkip(ns, cf, ct)
+					}
 					return
 				}
 			}
@@ -285,9 +364,18 @@ OUTER:
 			case reflect.Slice, reflect.Array:
 
 				var i64 int64
-				reusableCF := &cField{}
+				reusableCF := &cField{labels: cf.labels}
+				sc := v.scope
 
 				for i := 0; i < current.Len(); i++ {
+					if sc != nil {
+						child, ok := sc.index(i)
+						if !ok {
+							continue
+						}
+						v.scope = child
+					}
+
 					i64 = int64(i)
 
 					v.misc = append(v.misc[0:0], cf.name...)
@@ -309,14 +397,29 @@ OUTER:
 					}
 					v.traverseField(ctx, parent, current.Index(i), ns, structNs, reusableCF, ct)
 				}
+				v.scope = sc
 
 			case reflect.Map:
 
 				var pv string
-				reusableCF := &cField{}
+				reusableCF := &cField{labels: cf.labels}
+				sc := v.scope
+
+				// values are visited in map order, each numbered alike so that
+				// sorting by field orders them by key rather than by visit
+				baseSeq, maxSeq := v.seq, v.seq
 
 				for _, key := range current.MapKeys() {
 					pv = fmt.Sprintf("%v", key)
+					v.seq = baseSeq
+
+					if sc != nil {
+						child, ok := sc.key(pv)
+						if !ok {
+							continue
+						}
+						v.scope = child
+					}
 
 					v.misc = append(v.misc[0:0], cf.name...)
 					v.misc = append(v.misc, '[')
@@ -357,7 +460,10 @@ OUTER:
 					} else {
 						v.traverseField(ctx, parent, current.MapIndex(key), ns, structNs, reusableCF, ct)
 					}
+					maxSeq = max(maxSeq, v.seq)
 				}
+				v.seq = maxSeq
+				v.scope = sc
 
 			default:
 				// throw error, if not a slice or map then should not have gotten here
@@ -372,13 +478,24 @@ OUTER:
 			v.misc = v.misc[0:0]
 
 			for {
+				if ct.checkParam {
+					v.checkDeferredParam(ct, typ)
+				}
+
 				// set Field Level fields
 				v.slflParent = parent
 				v.flField = current
 				v.cf = cf
 				v.ct = ct
 
-				if ct.fn(ctx, v) {
+				var ok bool
+				if v.v.observer == nil && v.trace == nil {
+					ok = ct.fn(ctx, v)
+				} else {
+					ok = v.observeValidation(ctx, ct, ns)
+				}
+
+				if ok {
 					if ct.isBlockEnd {
 						ct = ct.next
 						continue OUTER
@@ -413,48 +530,44 @@ OUTER:
 
 				if ct.isBlockEnd || ct.next == nil {
 					// if we get here, no valid 'or' value and no more tags
-					v.str1 = appendAltName(ns, cf.altName)
-
-					if v.v.hasTagNameFunc {
-						v.str2 = string(append(structNs, cf.name...))
-					} else {
-						v.str2 = v.str1
-					}
+					v.str1, v.str2 = v.namespaces(ns, structNs, cf)
 
 					if ct.hasAlias {
-						v.errs = append(v.errs,
-							&fieldError{
-								v:              v.v,
-								tag:            ct.aliasTag,
-								actualTag:      ct.actualAliasTag,
-								ns:             v.str1,
-								structNs:       v.str2,
-								fieldLen:       uint8(len(cf.altName)),
-								structfieldLen: uint8(len(cf.name)),
-								value:          getValue(current),
-								param:          ct.param,
-								kind:           kind,
-								typ:            typ,
-							},
-						)
+						v.appendError(fieldError{
+							v:              v.v,
+							tag:            ct.aliasTag,
+							actualTag:      ct.actualAliasTag,
+							ns:             v.str1,
+							structNs:       v.str2,
+							fieldLen:       uint8(len(cf.altName)),
+							structfieldLen: uint8(len(cf.name)),
+							value:          getValue(current),
+							param:          ct.param,
+							seq:            v.seq,
+							labels:         cf.labels,
+							sensitive:      ct.sensitive,
+							kind:           kind,
+							typ:            typ,
+						})
 					} else {
 						tVal := string(v.misc)[1:]
 
-						v.errs = append(v.errs,
-							&fieldError{
-								v:              v.v,
-								tag:            tVal,
-								actualTag:      tVal,
-								ns:             v.str1,
-								structNs:       v.str2,
-								fieldLen:       uint8(len(cf.altName)),
-								structfieldLen: uint8(len(cf.name)),
-								value:          getValue(current),
-								param:          ct.param,
-								kind:           kind,
-								typ:            typ,
-							},
-						)
+						v.appendError(fieldError{
+							v:              v.v,
+							tag:            tVal,
+							actualTag:      tVal,
+							ns:             v.str1,
+							structNs:       v.str2,
+							fieldLen:       uint8(len(cf.altName)),
+							structfieldLen: uint8(len(cf.name)),
+							value:          getValue(current),
+							param:          ct.param,
+							seq:            v.seq,
+							labels:         cf.labels,
+							sensitive:      ct.sensitive,
+							kind:           kind,
+							typ:            typ,
+						})
 					}
 
 					return
@@ -464,6 +577,9 @@ OUTER:
 			}
 
 		default:
+			if ct.checkParam {
+				v.checkDeferredParam(ct, typ)
+			}
 
 			// set Field Level fields
 			v.slflParent = parent
@@ -471,30 +587,32 @@ OUTER:
 			v.cf = cf
 			v.ct = ct
 
-			if !ct.fn(ctx, v) {
-				v.str1 = appendAltName(ns, cf.altName)
-
-				if v.v.hasTagNameFunc {
-					v.str2 = string(append(structNs, cf.name...))
-				} else {
-					v.str2 = v.str1
-				}
+			var ok bool
+			if v.v.observer == nil && v.trace == nil {
+				ok = ct.fn(ctx, v)
+			} else {
+				ok = v.observeValidation(ctx, ct, ns)
+			}
 
-				v.errs = append(v.errs,
-					&fieldError{
-						v:              v.v,
-						tag:            ct.aliasTag,
-						actualTag:      ct.tag,
-						ns:             v.str1,
-						structNs:       v.str2,
-						fieldLen:       uint8(len(cf.altName)),
-						structfieldLen: uint8(len(cf.name)),
-						value:          getValue(current),
-						param:          ct.param,
-						kind:           kind,
-						typ:            typ,
-					},
-				)
+			if !ok {
+				v.str1, v.str2 = v.namespaces(ns, structNs, cf)
+
+				v.appendError(fieldError{
+					v:              v.v,
+					tag:            ct.aliasTag,
+					actualTag:      ct.tag,
+					ns:             v.str1,
+					structNs:       v.str2,
+					fieldLen:       uint8(len(cf.altName)),
+					structfieldLen: uint8(len(cf.name)),
+					value:          getValue(current),
+					param:          ct.param,
+					seq:            v.seq,
+					labels:         cf.labels,
+					sensitive:      ct.sensitive,
+					kind:           kind,
+					typ:            typ,
+				})
 
 				return
 			}
@@ -503,14 +621,77 @@ OUTER:
 	}
 }
 
-func appendAltName(ns []byte, altName string) string {
-	if len(altName) > 0 {
-		return string(append(ns, altName...))
+// namespaces returns the namespace and struct namespace of the field cf for an
+// error, written into errBuf rather than allocating strings for each error.
+func (v *validate) namespaces(ns []byte, structNs []byte, cf *cField) (string, string) {
+	v.reserveErrBuf()
+	start := len(v.errBuf)
+	if len(cf.altName) > 0 {
+		v.errBuf = append(append(v.errBuf, ns...), cf.altName...)
+	} else if n := len(ns); n > 0 && ns[n-1] == '.' {
+		v.errBuf = append(v.errBuf, ns[:n-1]...)
+	} else {
+		v.errBuf = append(v.errBuf, ns...)
+	}
+	nsStr := v.errString(start)
+
+	if !v.v.hasTagNameFunc {
+		return nsStr, nsStr
+	}
+
+	start = len(v.errBuf)
+	v.errBuf = append(append(v.errBuf, structNs...), cf.name...)
+	return nsStr, v.errString(start)
+}
+
+// reserveErrBuf allocates errBuf when validating into a Result with the capacity
+// its namespaces needed last time. Each run has a fresh buffer, never reusing
+// that of an earlier run whose namespaces may still be referenced.
+func (v *validate) reserveErrBuf() {
+	if v.errBuf == nil && v.result != nil && v.result.bufCap > 0 {
+		v.errBuf = make([]byte, 0, v.result.bufCap)
+	}
+}
+
+// errString returns the bytes of errBuf from start as a string without copying
+// them, which is safe as errBuf is only appended to until handed over with errs
+// and is never reused once handed over.
+func (v *validate) errString(start int) string {
+	if start == len(v.errBuf) {
+		return ""
 	}
-	if n := len(ns); n > 0 && ns[n-1] == '.' {
-		return string(ns[:n-1])
+	return unsafe.String(&v.errBuf[start], len(v.errBuf)-start)
+}
+
+// appendError adds fe to errs, storing it in the Result when validating into one.
+func (v *validate) appendError(fe fieldError) {
+	var e *fieldError
+	if v.result != nil {
+		e = v.result.newError()
+	} else {
+		e = new(fieldError)
+	}
+
+	if fe.sensitive || v.isSensitiveValue(fe) {
+		// the value is never kept, so it cannot leak through the error
+		fe.sensitive = true
+		fe.value = nil
+	}
+
+	*e = fe
+	v.errs = append(v.errs, e)
+}
+
+// isSensitiveValue returns whether fe's value is or holds a sensitive value,
+// walking it only when its type holds interfaces.
+func (v *validate) isSensitiveValue(fe fieldError) bool {
+	switch v.v.sensitivityOf(fe.typ) {
+	case isSensitive:
+		return true
+	case maybeSensitive:
+		return v.v.holdsSensitiveValue(reflect.ValueOf(fe.value), make(map[sensitiveVisit]struct{}))
 	}
-	return string(ns)
+	return false
 }
 
 func getValue(val reflect.Value) interface{} {
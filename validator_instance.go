@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -25,6 +27,7 @@ const (
 	omitempty             = "omitempty"
 	omitnil               = "omitnil"
 	isdefault             = "isdefault"
+	sensitiveTagKey       = "sensitive"
 	requiredWithoutAllTag = "required_without_all"
 	requiredWithoutTag    = "required_without"
 	requiredWithTag       = "required_with"
@@ -54,8 +57,12 @@ const (
 var (
 	timeDurationType = reflect.TypeOf(time.Duration(0))
 	timeType         = reflect.TypeOf(time.Time{})
+	stringType       = reflect.TypeOf("")
+	int64Type        = reflect.TypeOf(int64(0))
+	float64Type      = reflect.TypeOf(float64(0))
 
 	byteSliceType = reflect.TypeOf([]byte{})
+	valuerType    = reflect.TypeOf((*Valuer)(nil)).Elem()
 
 	defaultCField = &cField{namesEqual: true}
 )
@@ -77,6 +84,7 @@ type TagNameFunc func(field reflect.StructField) string
 type internalValidationFuncWrapper struct {
 	fn                 FuncCtx
 	runValidationOnNil bool
+	info               ValidationInfo
 }
 
 // Validate contains the validator settings and cache
@@ -86,14 +94,27 @@ type Validate struct {
 	tagNameFunc            TagNameFunc
 	structLevelFuncs       map[reflect.Type]StructLevelFuncCtx
 	customFuncs            map[reflect.Type]CustomTypeFunc
+	sensitiveTypes         map[reflect.Type]struct{}
 	aliases                map[string]string
 	validations            map[string]internalValidationFuncWrapper
-	transTagFunc           map[ut.Translator]map[string]TranslationFunc // map[<locale>]map[<tag>]TranslationFunc
+	modifiers              map[string]ModifierFunc
+	modTagName             string
+	defaultTagName         string
+	transTagFunc           map[ut.Translator]map[string]TranslationFuncCtx // map[<locale>]map[<tag>]TranslationFuncCtx
+	transLocales           map[string]ut.Translator                        // map[<locale>]<translator registered>
+	transFallbacks         map[ut.Translator][]ut.Translator
+	uni                    *ut.UniversalTranslator
+	localeResolver         LocaleResolver
+	labels                 map[reflect.Type]map[string]map[string]string // map[<struct type>]map[<field>]map[<locale>]<label>
 	rules                  map[reflect.Type]map[string]string
 	tagCache               *tagCache
 	structCache            *structCache
+	observer               Observer
 	hasCustomFuncs         bool
 	hasTagNameFunc         bool
+	hasModifiers           bool
+	hasDefaults            bool
+	strictTags             bool
 	requiredStructEnabled  bool
 	privateFieldValidation bool
 	omitBlankFieldNames    bool
@@ -105,18 +126,15 @@ type Validate struct {
 // in essence only parsing your validation tags once per struct type.
 // Using multiple instances neglects the benefit of caching.
 func New(options ...Option) *Validate {
-	tc := new(tagCache)
-	tc.m.Store(make(map[string]*cTag))
-
-	sc := new(structCache)
-	sc.m.Store(make(map[reflect.Type]*cStruct))
-
 	v := &Validate{
-		tagName:     defaultTagName,
-		aliases:     make(map[string]string, len(bakedInAliases)),
-		validations: make(map[string]internalValidationFuncWrapper, len(bakedInValidators)),
-		tagCache:    tc,
-		structCache: sc,
+		tagName:        defaultTagName,
+		aliases:        make(map[string]string, len(bakedInAliases)),
+		validations:    make(map[string]internalValidationFuncWrapper, len(bakedInValidators)),
+		modifiers:      make(map[string]ModifierFunc, len(bakedInModifiers)),
+		modTagName:     defaultModTagName,
+		defaultTagName: defaultDefaultTagName,
+		tagCache:       new(tagCache),
+		structCache:    new(structCache),
 	}
 
 	// must copy alias validators for separate validations to be used in each validator instance
@@ -138,26 +156,109 @@ func New(options ...Option) *Validate {
 		}
 	}
 
-	v.pool = &sync.Pool{
+	for k, val := range bakedInModifiers {
+		_ = v.registerModifier(k, val, true)
+	}
+
+	v.pool = newPool(v)
+
+	for _, o := range options {
+		o(v)
+	}
+	return v
+}
+
+// Clone returns a new instance inheriting every registration and option of v
+// with empty caches, applying options to it. Registrations made on the clone,
+// eg. by a tenant or plugin, don't affect v, so it can be configured while v is
+// in use.
+func (v *Validate) Clone(options ...Option) *Validate {
+	c := &Validate{
+		tagName:                v.tagName,
+		tagNameFunc:            v.tagNameFunc,
+		structLevelFuncs:       maps.Clone(v.structLevelFuncs),
+		customFuncs:            maps.Clone(v.customFuncs),
+		sensitiveTypes:         maps.Clone(v.sensitiveTypes),
+		aliases:                maps.Clone(v.aliases),
+		validations:            maps.Clone(v.validations),
+		modifiers:              maps.Clone(v.modifiers),
+		transLocales:           maps.Clone(v.transLocales),
+		modTagName:             v.modTagName,
+		defaultTagName:         v.defaultTagName,
+		tagCache:               &tagCache{max: v.tagCache.max},
+		structCache:            new(structCache),
+		observer:               v.observer,
+		uni:                    v.uni,
+		localeResolver:         v.localeResolver,
+		hasCustomFuncs:         v.hasCustomFuncs,
+		hasTagNameFunc:         v.hasTagNameFunc,
+		hasModifiers:           v.hasModifiers,
+		hasDefaults:            v.hasDefaults,
+		strictTags:             v.strictTags,
+		requiredStructEnabled:  v.requiredStructEnabled,
+		privateFieldValidation: v.privateFieldValidation,
+		omitBlankFieldNames:    v.omitBlankFieldNames,
+	}
+
+	if v.transTagFunc != nil {
+		c.transTagFunc = make(map[ut.Translator]map[string]TranslationFuncCtx, len(v.transTagFunc))
+		for trans, m := range v.transTagFunc {
+			c.transTagFunc[trans] = maps.Clone(m)
+		}
+	}
+
+	if v.transFallbacks != nil {
+		c.transFallbacks = make(map[ut.Translator][]ut.Translator, len(v.transFallbacks))
+		for trans, fallbacks := range v.transFallbacks {
+			c.transFallbacks[trans] = slices.Clone(fallbacks)
+		}
+	}
+
+	if v.labels != nil {
+		c.labels = make(map[reflect.Type]map[string]map[string]string, len(v.labels))
+		for typ, fields := range v.labels {
+			c.labels[typ] = make(map[string]map[string]string, len(fields))
+			for field, labels := range fields {
+				c.labels[typ][field] = maps.Clone(labels)
+			}
+		}
+	}
+
+	if v.rules != nil {
+		c.rules = make(map[reflect.Type]map[string]string, len(v.rules))
+		for typ, rules := range v.rules {
+			c.rules[typ] = maps.Clone(rules)
+		}
+	}
+
+	c.pool = newPool(c)
+
+	for _, o := range options {
+		o(c)
+	}
+	return c
+}
+
+func newPool(v *Validate) *sync.Pool {
+	return &sync.Pool{
 		New: func() interface{} {
 			return &validate{
 				v:        v,
 				ns:       make([]byte, 0, 64),
 				actualNs: make([]byte, 0, 64),
 				misc:     make([]byte, 32),
+				strVal:   reflect.New(stringType).Elem(),
+				intVal:   reflect.New(int64Type).Elem(),
+				floatVal: reflect.New(float64Type).Elem(),
 			}
 		},
 	}
-
-	for _, o := range options {
-		o(v)
-	}
-	return v
 }
 
 // SetTagName allows for changing of the default tag name of 'validate'
 func (v *Validate) SetTagName(name string) {
 	v.tagName = name
+	v.clearCaches()
 }
 
 // ValidateMapCtx validates a map using a map of validation rules and allows passing of contextual
@@ -211,6 +312,7 @@ func (v *Validate) ValidateMap(data map[string]interface{}, rules map[string]int
 func (v *Validate) RegisterTagNameFunc(fn TagNameFunc) {
 	v.tagNameFunc = fn
 	v.hasTagNameFunc = true
+	v.clearCaches()
 }
 
 // RegisterValidation adds a validation with the given tag
@@ -245,6 +347,7 @@ func (v *Validate) RegisterAlias(alias, tags string) {
 	}
 
 	v.aliases[alias] = tags
+	v.clearCaches()
 }
 
 // RegisterStructValidation registers a StructLevelFunc against a number of types.
@@ -273,6 +376,7 @@ func (v *Validate) RegisterStructValidationCtx(fn StructLevelFuncCtx, types ...i
 
 		v.structLevelFuncs[reflect.TypeOf(t)] = fn
 	}
+	v.clearCaches()
 }
 
 // RegisterStructValidationMapRules registers validate map rules.
@@ -301,6 +405,7 @@ func (v *Validate) RegisterStructValidationMapRules(rules map[string]string, typ
 		}
 		v.rules[typ] = deepCopyRules
 	}
+	v.clearCaches()
 }
 
 // RegisterCustomTypeFunc registers a CustomTypeFunc against a number of types
@@ -316,12 +421,191 @@ func (v *Validate) RegisterCustomTypeFunc(fn CustomTypeFunc, types ...interface{
 	}
 
 	v.hasCustomFuncs = true
+	v.clearCaches()
+}
+
+// RegisterSensitiveType marks the values of a number of types as sensitive, like
+// the struct tag sensitive:"true" does for a field, so FieldError.Value() returns
+// RedactedValue for fields of these types instead of their value eg. a
+// password or token type.
+//
+// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+func (v *Validate) RegisterSensitiveType(types ...interface{}) {
+	if v.sensitiveTypes == nil {
+		v.sensitiveTypes = make(map[reflect.Type]struct{})
+	}
+
+	for _, t := range types {
+		v.sensitiveTypes[reflect.TypeOf(t)] = struct{}{}
+	}
+	v.clearCaches()
+}
+
+// sensitivity is whether values of a type are sensitive, see
+// Validate.sensitivityOf.
+type sensitivity uint8
+
+const (
+	notSensitive   sensitivity = iota
+	maybeSensitive             // only known from the values held by interfaces
+	isSensitive
+)
+
+// sensitivityOf returns whether values of typ are sensitive, being of a type
+// registered with RegisterSensitiveType or holding, within structs, pointers,
+// slices, arrays or maps, a field tagged sensitive:"true" or of such a type eg.
+// a struct holding a password whose error for 'required' would otherwise carry
+// it. Types holding interfaces are maybeSensitive, their values having to be
+// checked using holdsSensitiveValue.
+func (v *Validate) sensitivityOf(typ reflect.Type) sensitivity {
+	if typ == nil {
+		return notSensitive
+	}
+
+	if s, ok := v.structCache.sensitive.Load(typ); ok {
+		return s.(sensitivity)
+	}
+
+	s := v.holdsSensitive(typ, make(map[reflect.Type]struct{}))
+	v.structCache.sensitive.Store(typ, s)
+	return s
+}
+
+// holdsSensitive returns whether values of typ are or hold sensitive values, seen
+// being the types already checked.
+func (v *Validate) holdsSensitive(typ reflect.Type, seen map[reflect.Type]struct{}) sensitivity {
+	if v.isSensitiveType(typ) {
+		return isSensitive
+	}
+
+	if _, ok := seen[typ]; ok {
+		return notSensitive
+	}
+	seen[typ] = struct{}{}
+
+	switch typ.Kind() {
+	case reflect.Interface:
+		return maybeSensitive
+
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return v.holdsSensitive(typ.Elem(), seen)
+
+	case reflect.Map:
+		return max(v.holdsSensitive(typ.Key(), seen), v.holdsSensitive(typ.Elem(), seen))
+
+	case reflect.Struct:
+		s := notSensitive
+		for i := 0; i < typ.NumField(); i++ {
+			fld := typ.Field(i)
+
+			if isSensitiveField(fld) {
+				return isSensitive
+			}
+			if s = max(s, v.holdsSensitive(fld.Type, seen)); s == isSensitive {
+				return s
+			}
+		}
+		return s
+	}
+	return notSensitive
+}
+
+// sensitiveVisit identifies a pointer, slice or map already walked by
+// holdsSensitiveValue, guarding against cycles.
+type sensitiveVisit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// holdsSensitiveValue returns whether val is or holds a sensitive value, looking
+// into the values held by interfaces, seen being the pointers, slices and maps
+// already walked.
+func (v *Validate) holdsSensitiveValue(val reflect.Value, seen map[sensitiveVisit]struct{}) bool {
+	if !val.IsValid() {
+		return false
+	}
+
+	switch v.sensitivityOf(val.Type()) {
+	case notSensitive:
+		return false
+	case isSensitive:
+		return true
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		if val.IsNil() {
+			return false
+		}
+
+		visit := sensitiveVisit{ptr: val.Pointer(), typ: val.Type()}
+		if _, ok := seen[visit]; ok {
+			return false
+		}
+		seen[visit] = struct{}{}
+	}
+
+	switch val.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		return v.holdsSensitiveValue(val.Elem(), seen)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			if v.holdsSensitiveValue(val.Index(i), seen) {
+				return true
+			}
+		}
+
+	case reflect.Map:
+		iter := val.MapRange()
+		for iter.Next() {
+			if v.holdsSensitiveValue(iter.Key(), seen) || v.holdsSensitiveValue(iter.Value(), seen) {
+				return true
+			}
+		}
+
+	case reflect.Struct:
+		for i := 0; i < val.NumField(); i++ {
+			if v.holdsSensitiveValue(val.Field(i), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isSensitiveType returns whether typ, or the type it points to, was registered
+// with RegisterSensitiveType.
+func (v *Validate) isSensitiveType(typ reflect.Type) bool {
+	if len(v.sensitiveTypes) == 0 || typ == nil {
+		return false
+	}
+
+	if _, ok := v.sensitiveTypes[typ]; ok {
+		return true
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		_, ok := v.sensitiveTypes[typ.Elem()]
+		return ok
+	}
+	return false
 }
 
 // RegisterTranslation registers translations against the provided tag.
 func (v *Validate) RegisterTranslation(tag string, trans ut.Translator, registerFn RegisterTranslationsFunc, translationFn TranslationFunc) (err error) {
+	return v.RegisterTranslationCtx(tag, trans, registerFn, func(_ context.Context, ut ut.Translator, fe FieldError) string {
+		return translationFn(ut, fe)
+	})
+}
+
+// RegisterTranslationCtx registers translations against the provided tag, the
+// translation function being passed the context errors are translated with
+// using TranslateCtx, or context.Background() using Translate.
+func (v *Validate) RegisterTranslationCtx(tag string, trans ut.Translator, registerFn RegisterTranslationsFunc, translationFn TranslationFuncCtx) (err error) {
 	if v.transTagFunc == nil {
-		v.transTagFunc = make(map[ut.Translator]map[string]TranslationFunc)
+		v.transTagFunc = make(map[ut.Translator]map[string]TranslationFuncCtx)
+		v.transLocales = make(map[string]ut.Translator)
 	}
 
 	if err = registerFn(trans); err != nil {
@@ -330,8 +614,9 @@ func (v *Validate) RegisterTranslation(tag string, trans ut.Translator, register
 
 	m, ok := v.transTagFunc[trans]
 	if !ok {
-		m = make(map[string]TranslationFunc)
+		m = make(map[string]TranslationFuncCtx)
 		v.transTagFunc[trans] = m
+		v.transLocales[trans.Locale()] = trans
 	}
 
 	m[tag] = translationFn
@@ -370,12 +655,13 @@ func (v *Validate) StructCtx(ctx context.Context, s interface{}) (err error) {
 	vd.isPartial = false
 	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
 
+	if (v.hasModifiers || v.hasDefaults) && val.CanAddr() {
+		vd.modifyStruct(ctx, val)
+	}
+
 	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
+	err = vd.takeErrors()
 
 	v.pool.Put(vd)
 
@@ -416,12 +702,13 @@ func (v *Validate) StructFilteredCtx(ctx context.Context, s interface{}, fn Filt
 	vd.ffn = fn
 	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
 
+	if (v.hasModifiers || v.hasDefaults) && val.CanAddr() {
+		vd.modifyStruct(ctx, val)
+	}
+
 	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
+	err = vd.takeErrors()
 
 	v.pool.Put(vd)
 
@@ -502,12 +789,13 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 		}
 	}
 
+	if (v.hasModifiers || v.hasDefaults) && val.CanAddr() {
+		vd.modifyStruct(ctx, val)
+	}
+
 	vd.validateStruct(ctx, top, val, typ, vd.ns[0:0], vd.actualNs[0:0], nil)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
+	err = vd.takeErrors()
 
 	v.pool.Put(vd)
 
@@ -566,12 +854,13 @@ func (v *Validate) StructExceptCtx(ctx context.Context, s interface{}, fields ..
 		vd.includeExclude[string(vd.misc)] = struct{}{}
 	}
 
+	if (v.hasModifiers || v.hasDefaults) && val.CanAddr() {
+		vd.modifyStruct(ctx, val)
+	}
+
 	vd.validateStruct(ctx, top, val, typ, vd.ns[0:0], vd.actualNs[0:0], nil)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
+	err = vd.takeErrors()
 
 	v.pool.Put(vd)
 
@@ -622,11 +911,87 @@ func (v *Validate) VarCtx(ctx context.Context, field interface{}, tag string) (e
 	vd.isPartial = false
 	vd.traverseField(ctx, val, val, vd.ns[0:0], vd.actualNs[0:0], defaultCField, ctag)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
+	err = vd.takeErrors()
+	v.pool.Put(vd)
+	return
+}
+
+// VarString validates a string using tag style validation like Var, without
+// the allocation of boxing the string in an interface.
+func (v *Validate) VarString(field string, tag string) error {
+	return v.VarStringCtx(context.Background(), field, tag)
+}
+
+// VarStringCtx does the same as VarString but allows passing of contextual
+// validation information via context.Context.
+func (v *Validate) VarStringCtx(ctx context.Context, field string, tag string) error {
+	if len(tag) == 0 || tag == skipValidationTag {
+		return nil
+	}
+
+	vd := v.pool.Get().(*validate)
+	vd.strVal.SetString(field)
+
+	err := v.varValue(ctx, vd, vd.strVal, tag)
+
+	vd.strVal.SetString("")
+	v.pool.Put(vd)
+	return err
+}
+
+// VarInt validates an int64 using tag style validation like Var, without
+// the allocation of boxing the int64 in an interface.
+func (v *Validate) VarInt(field int64, tag string) error {
+	return v.VarIntCtx(context.Background(), field, tag)
+}
+
+// VarIntCtx does the same as VarInt but allows passing of contextual
+// validation information via context.Context.
+func (v *Validate) VarIntCtx(ctx context.Context, field int64, tag string) error {
+	if len(tag) == 0 || tag == skipValidationTag {
+		return nil
 	}
+
+	vd := v.pool.Get().(*validate)
+	vd.intVal.SetInt(field)
+
+	err := v.varValue(ctx, vd, vd.intVal, tag)
+
 	v.pool.Put(vd)
+	return err
+}
+
+// VarFloat validates a float64 using tag style validation like Var, without
+// the allocation of boxing the float64 in an interface.
+func (v *Validate) VarFloat(field float64, tag string) error {
+	return v.VarFloatCtx(context.Background(), field, tag)
+}
+
+// VarFloatCtx does the same as VarFloat but allows passing of contextual
+// validation information via context.Context.
+func (v *Validate) VarFloatCtx(ctx context.Context, field float64, tag string) error {
+	if len(tag) == 0 || tag == skipValidationTag {
+		return nil
+	}
+
+	vd := v.pool.Get().(*validate)
+	vd.floatVal.SetFloat(field)
+
+	err := v.varValue(ctx, vd, vd.floatVal, tag)
+
+	v.pool.Put(vd)
+	return err
+}
+
+// varValue validates val, one of vd's reusable values, using tag.
+func (v *Validate) varValue(ctx context.Context, vd *validate, val reflect.Value, tag string) (err error) {
+	ctag := v.fetchCacheTag(tag)
+
+	vd.top = val
+	vd.isPartial = false
+	vd.traverseField(ctx, val, val, vd.ns[0:0], vd.actualNs[0:0], defaultCField, ctag)
+
+	err = vd.takeErrors()
 	return
 }
 
@@ -674,10 +1039,7 @@ func (v *Validate) VarWithValueCtx(ctx context.Context, field interface{}, other
 	vd.isPartial = false
 	vd.traverseField(ctx, otherVal, reflect.ValueOf(field), vd.ns[0:0], vd.actualNs[0:0], defaultCField, ctag)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
+	err = vd.takeErrors()
 	v.pool.Put(vd)
 	return
 }
@@ -732,10 +1094,7 @@ func (v *Validate) VarWithKeyCtx(ctx context.Context, key string, field interfac
 	vd.isPartial = false
 	vd.traverseField(ctx, val, val, vd.ns[0:0], vd.actualNs[0:0], cField, ctag)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
+	err = vd.takeErrors()
 	v.pool.Put(vd)
 	return
 }
@@ -753,6 +1112,19 @@ func (v *Validate) registerValidation(tag string, fn FuncCtx, bakedIn bool, nilC
 	if !bakedIn && (ok || strings.ContainsAny(tag, restrictedTagChars)) {
 		panic(fmt.Sprintf(restrictedTagErr, tag))
 	}
-	v.validations[tag] = internalValidationFuncWrapper{fn: fn, runValidationOnNil: nilCheckable}
+	info := ValidationInfo{Tag: tag, CallEvenIfNull: nilCheckable}
+	if bakedIn {
+		info = bakedInValidationInfo[tag]
+		info.Tag = tag
+		info.CallEvenIfNull = nilCheckable
+		info.BakedIn = true
+	}
+
+	v.validations[tag] = internalValidationFuncWrapper{fn: fn, runValidationOnNil: nilCheckable, info: info}
+
+	// baked in validations are only registered by New, before anything is cached
+	if !bakedIn {
+		v.clearCaches()
+	}
 	return nil
 }
@@ -17,13 +17,18 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	. "github.com/go-playground/assert/v2"
 	"github.com/go-playground/locales/en"
 	"github.com/go-playground/locales/fr"
+	"github.com/go-playground/locales/fr_CA"
 	"github.com/go-playground/locales/nl"
 	ut "github.com/go-playground/universal-translator"
 )
@@ -8025,6 +8030,27 @@ func TestBadParams(t *testing.T) {
 
 	f := 1.23
 	PanicMatches(t, func() { _ = validate.Var(f, "len=a") }, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+
+	type Conditional struct {
+		Count int
+		Name  string `validate:"required_if=Count many"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(Conditional{Count: 1}) }, "strconv.ParseInt: parsing \"many\": invalid syntax")
+
+	type BadLen struct {
+		Name string `validate:"omitempty,len=a"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(BadLen{}) }, "Invalid parameter 'a' for validation 'len' on field 'Name': strconv.ParseInt: parsing \"a\": invalid syntax")
+
+	p := newParam("a")
+	PanicMatches(t, func() { _ = p.asInt() }, "strconv.ParseInt: parsing \"a\": invalid syntax")
+	PanicMatches(t, func() { _ = p.asIntFromType(timeDurationType) }, "strconv.ParseInt: parsing \"a\": invalid syntax")
+	PanicMatches(t, func() { _ = p.asUint() }, "strconv.ParseUint: parsing \"a\": invalid syntax")
+	PanicMatches(t, func() { _ = p.asFloat64() }, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+	PanicMatches(t, func() { _ = p.asFloat32() }, "strconv.ParseFloat: parsing \"a\": invalid syntax")
+	PanicMatches(t, func() { _ = p.asBool() }, "strconv.ParseBool: parsing \"a\": invalid syntax")
 }
 
 func TestLength(t *testing.T) {
@@ -16761,3 +16787,1900 @@ func TestValuerInterface(t *testing.T) {
 		}
 	})
 }
+
+func TestModifiers(t *testing.T) {
+	type Inner struct {
+		Code string `mod:"trim,upper" validate:"len=3"`
+	}
+
+	type Test struct {
+		Email   string            `mod:"trim,lower" validate:"required,email"`
+		Name    *string           `mod:"collapse,trim" validate:"required,max=20"`
+		Tags    []string          `mod:"dive,ltrim=#,rtrim"`
+		Labels  map[string]string `mod:"dive,lower"`
+		Inner   Inner
+		Inners  []*Inner `mod:"dive" validate:"dive"`
+		Nothing *string  `mod:"trim"`
+	}
+
+	name := "  Joey   Bloggs "
+
+	s := &Test{
+		Email:  "  Joey.Bloggs@Example.COM \t",
+		Name:   &name,
+		Tags:   []string{"##go  ", "#validator\n"},
+		Labels: map[string]string{"a": "ONE", "b": "Two"},
+		Inner:  Inner{Code: " abc "},
+		Inners: []*Inner{{Code: "def"}, nil},
+	}
+
+	validate := New(WithModifiers())
+
+	errs := validate.Struct(s)
+	Equal(t, errs, nil)
+	Equal(t, s.Email, "joey.bloggs@example.com")
+	Equal(t, *s.Name, "Joey Bloggs")
+	Equal(t, s.Tags, []string{"go", "validator"})
+	Equal(t, s.Labels, map[string]string{"a": "one", "b": "two"})
+	Equal(t, s.Inner.Code, "ABC")
+	Equal(t, s.Inners[0].Code, "DEF")
+	Equal(t, s.Nothing, nil)
+
+	// not addressable, modifiers cannot run
+	errs = validate.Struct(Test{Email: " Joey.Bloggs@Example.COM", Name: &name})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Email", "Test.Email", "Email", "Email", "email")
+
+	// disabled by default
+	s.Email = " joey@example.com "
+	errs = New().Struct(s)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Email", "Test.Email", "Email", "Email", "email")
+
+	err := validate.Modify(s)
+	Equal(t, err, nil)
+	Equal(t, s.Email, "joey@example.com")
+
+	err = validate.Modify(*s)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: (nil validator.Test)")
+
+	type Custom struct {
+		Value string `transform:"reverse" validate:"eq=cba"`
+	}
+
+	validate = New(WithModifiers())
+	validate.SetModifierTagName("transform")
+	err = validate.RegisterModifier("reverse", func(ctx context.Context, fl FieldLevel) {
+		r := []rune(fl.Field().String())
+		for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+			r[i], r[j] = r[j], r[i]
+		}
+		fl.Field().SetString(string(r))
+	})
+	Equal(t, err, nil)
+
+	c := &Custom{Value: "abc"}
+	Equal(t, validate.Struct(c), nil)
+	Equal(t, c.Value, "cba")
+
+	NotEqual(t, validate.RegisterModifier("", nil), nil)
+	NotEqual(t, validate.RegisterModifier("abc", nil), nil)
+	PanicMatches(t, func() {
+		_ = validate.RegisterModifier("dive", func(ctx context.Context, fl FieldLevel) {})
+	}, "Tag 'dive' either contains restricted characters or is the same as a restricted tag needed for normal operation")
+
+	type Undefined struct {
+		Value string `transform:"nope"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(&Undefined{}) }, "Undefined modifier function 'nope' on field 'Value'")
+}
+
+func TestDefaults(t *testing.T) {
+	type Inner struct {
+		Name string `default:"inner" mod:"upper"`
+	}
+
+	type Config struct {
+		Host     string        `default:"localhost" validate:"required,hostname"`
+		Port     int           `default:"8080" validate:"gte=1,lte=65535"`
+		Ratio    float32       `default:"0.5"`
+		Mask     uint8         `default:"0x0f"`
+		Debug    bool          `default:"true"`
+		Timeout  time.Duration `default:"1m30s"`
+		Hosts    []string      `default:"a.example.com, b.example.com" validate:"dive,hostname"`
+		Ports    []*int        `default:"1,2"`
+		Retries  *int          `default:"3" validate:"omitnil,gte=1"`
+		Backoff  *int          `default:"5" validate:"omitnil"`
+		Codes    []int         `default:"200" validate:"omitzero"`
+		IP       net.IP        `default:"127.0.0.1"`
+		Inner    Inner
+		InnerPtr *Inner
+		Unset    string
+	}
+
+	validate := New(WithDefaults(), WithModifiers())
+
+	zero := 0
+
+	c := &Config{
+		Port:    9090,
+		Backoff: &zero,
+		Codes:   []int{},
+	}
+
+	Equal(t, validate.Struct(c), nil)
+	Equal(t, c.Host, "localhost")
+	Equal(t, c.Port, 9090)
+	Equal(t, c.Ratio, float32(0.5))
+	Equal(t, c.Mask, uint8(15))
+	Equal(t, c.Debug, true)
+	Equal(t, c.Timeout, 90*time.Second)
+	Equal(t, c.Hosts, []string{"a.example.com", "b.example.com"})
+	Equal(t, len(c.Ports), 2)
+	Equal(t, *c.Ports[0], 1)
+	Equal(t, *c.Ports[1], 2)
+	Equal(t, *c.Retries, 3)
+	Equal(t, *c.Backoff, 0)
+	Equal(t, c.Codes, []int{200})
+	Equal(t, c.IP.String(), "127.0.0.1")
+	Equal(t, c.Inner.Name, "INNER")
+	Equal(t, c.InnerPtr, nil)
+	Equal(t, c.Unset, "")
+
+	// each struct receives its own copy of slice and pointer defaults
+	c2 := &Config{}
+	Equal(t, validate.Struct(c2), nil)
+	c2.Hosts[0] = "changed.example.com"
+	*c2.Retries = 10
+	Equal(t, c.Hosts[0], "a.example.com")
+	Equal(t, *c.Retries, 3)
+	Equal(t, *c2.Backoff, 5)
+
+	// without the option the tag is ignored
+	c3 := &Config{}
+	errs := New().Struct(c3)
+	NotEqual(t, errs, nil)
+	Equal(t, c3.Port, 0)
+
+	type Bad struct {
+		Port int `default:"eighty"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(&Bad{}) }, "Invalid default value 'eighty' on field 'Port': strconv.ParseInt: parsing \"eighty\": invalid syntax")
+
+	type Unsupported struct {
+		Values map[string]string `default:"a"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(&Unsupported{}) }, "Invalid default value 'a' on field 'Values': unsupported type map[string]string")
+
+	type Renamed struct {
+		Name string `fallback:"joey" validate:"required"`
+	}
+
+	validate = New(WithDefaults())
+	validate.SetDefaultTagName("fallback")
+
+	r := &Renamed{}
+	Equal(t, validate.Struct(r), nil)
+	Equal(t, r.Name, "joey")
+}
+
+func TestStructPresent(t *testing.T) {
+	type Address struct {
+		Street string `json:"street" validate:"required"`
+		City   string `json:"city" validate:"required,min=2"`
+	}
+
+	type Item struct {
+		SKU   string `json:"sku" validate:"required"`
+		Price int    `json:"price" validate:"required,gt=0"`
+	}
+
+	type Audit struct {
+		Reason string `json:"reason" validate:"required"`
+	}
+
+	type Patch struct {
+		Audit
+		Name    string            `json:"name" validate:"required,min=3"`
+		Email   string            `json:"email" validate:"required,email"`
+		Address *Address          `json:"address" validate:"required"`
+		Items   []Item            `json:"items" validate:"dive"`
+		Labels  map[string]string `json:"labels" validate:"dive,required"`
+	}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	decode := func(raw string) (*Patch, *Present) {
+		var p Patch
+		Equal(t, json.Unmarshal([]byte(raw), &p), nil)
+
+		present, err := PresentFromJSON(json.RawMessage(raw))
+		Equal(t, err, nil)
+		return &p, present
+	}
+
+	// absent fields are not required
+	p, present := decode(`{"name":"Joey"}`)
+	Equal(t, validate.StructPresent(p, present), nil)
+	NotEqual(t, validate.Struct(p), nil)
+
+	// but present ones are validated in full
+	p, present = decode(`{"name":"","email":"nope"}`)
+	errs := validate.StructPresent(p, present)
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Patch.name", "Patch.Name", "name", "Name", "required")
+	AssertError(t, errs, "Patch.email", "Patch.Email", "email", "Email", "email")
+
+	// nested objects only validate their present keys
+	p, present = decode(`{"address":{"city":"X"}}`)
+	errs = validate.StructPresent(p, present)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Patch.address.city", "Patch.Address.City", "city", "City", "min")
+
+	// present null fails required on the pointer itself
+	p, present = decode(`{"address":null}`)
+	errs = validate.StructPresent(p, present)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Patch.address", "Patch.Address", "address", "Address", "required")
+
+	// array items and map values
+	p, present = decode(`{"items":[{"sku":"a"},{"sku":"b","price":0}],"labels":{"a":""},"reason":""}`)
+	errs = validate.StructPresent(p, present)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Patch.Audit.reason", "Patch.Audit.Reason", "reason", "Reason", "required")
+	AssertError(t, errs, "Patch.items[1].price", "Patch.Items[1].Price", "price", "Price", "required")
+	AssertError(t, errs, "Patch.labels[a]", "Patch.Labels[a]", "labels[a]", "Labels[a]", "required")
+
+	// keys are matched case-insensitively, as encoding/json does
+	p, present = decode(`{"EMAIL":"nope"}`)
+	errs = validate.StructPresent(p, present)
+	AssertError(t, errs, "Patch.email", "Patch.Email", "email", "Email", "email")
+
+	// of keys differing only by case the first in order is used
+	_, cased := decode(`{"aDDRESS":{"street":"x"},"ADDRESS":{"city":"X"}}`)
+	for i := 0; i < 20; i++ {
+		errs = validate.StructPresent(&Patch{Address: &Address{City: "X"}}, cased)
+		Equal(t, len(errs.(ValidationErrors)), 1)
+		AssertError(t, errs, "Patch.address.city", "Patch.Address.City", "city", "City", "min")
+	}
+
+	// only the JSON name is matched, never the Go field name
+	type Contact struct {
+		Mail string `json:"email" validate:"required"`
+	}
+
+	var c Contact
+	present, err := PresentFromJSON(json.RawMessage(`{"Mail":""}`))
+	Equal(t, err, nil)
+	Equal(t, validate.StructPresent(&c, present), nil)
+
+	present, err = PresentFromJSON(json.RawMessage(`{"EMAIL":""}`))
+	Equal(t, err, nil)
+	AssertError(t, validate.StructPresent(&c, present), "Contact.email", "Contact.Mail", "email", "Mail", "required")
+
+	// nil present validates everything
+	NotEqual(t, validate.StructPresent(&Patch{}, nil), nil)
+
+	_, present = decode(`{"EMAIL":"nope"}`)
+
+	Equal(t, present.Has("EMAIL"), true)
+	Equal(t, present.Has("email"), false)
+	Equal(t, present.Field("missing"), (*Present)(nil))
+
+	present, err = PresentFromJSON(json.RawMessage(`{"items":[{"sku":"a"}]}`))
+	Equal(t, err, nil)
+	Equal(t, present.Field("items").Index(0).Has("sku"), true)
+	Equal(t, present.Field("items").Index(1), (*Present)(nil))
+
+	_, err = PresentFromJSON(json.RawMessage(`{`))
+	NotEqual(t, err, nil)
+
+	err = validate.StructPresent(1, nil)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: (nil int)")
+}
+
+func TestStructMask(t *testing.T) {
+	type Address struct {
+		Street string `json:"street" validate:"required"`
+		Zip    string `json:"zip" validate:"required,len=5"`
+	}
+
+	type Item struct {
+		SKU   string `json:"sku" validate:"required"`
+		Price int    `json:"price" validate:"gt=0"`
+	}
+
+	type Meta struct {
+		Source string `json:"source" validate:"required"`
+		Region string `json:"region" validate:"required"`
+	}
+
+	type Order struct {
+		Name      string            `json:"name" validate:"required"`
+		Items     []Item            `json:"items" validate:"dive"`
+		Meta      Meta              `json:"meta"`
+		Addresses []Address         `json:"addresses" validate:"dive"`
+		Labels    map[string]string `json:"labels" validate:"dive,required"`
+	}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
+
+	o := &Order{
+		Items:     []Item{{SKU: "a"}, {Price: 1}},
+		Addresses: []Address{{Zip: "1"}, {}},
+		Labels:    map[string]string{"env": "", "team": ""},
+	}
+
+	mask := MustCompileFieldMask("items[*].price")
+	errs := validate.StructMask(o, mask)
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Order.items[0].price", "Order.Items[0].Price", "price", "Price", "gt")
+
+	// selectors may be omitted and struct names used
+	errs = validate.StructMask(o, MustCompileFieldMask("Items.SKU"))
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Order.items[1].sku", "Order.Items[1].SKU", "sku", "SKU", "required")
+
+	errs = validate.StructMask(o, MustCompileFieldMask("meta.*"))
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "Order.meta.source", "Order.Meta.Source", "source", "Source", "required")
+	AssertError(t, errs, "Order.meta.region", "Order.Meta.Region", "region", "Region", "required")
+
+	errs = validate.StructMask(o, MustCompileFieldMask("addresses[0].zip"))
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 1)
+	AssertError(t, errs, "Order.addresses[0].zip", "Order.Addresses[0].Zip", "zip", "Zip", "len")
+
+	errs = validate.StructMask(o, MustCompileFieldMask("addresses[1]", "labels[env]"))
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "Order.addresses[1].street", "Order.Addresses[1].Street", "street", "Street", "required")
+	AssertError(t, errs, "Order.addresses[1].zip", "Order.Addresses[1].Zip", "zip", "Zip", "required")
+	AssertError(t, errs, "Order.labels[env]", "Order.Labels[env]", "labels[env]", "Labels[env]", "required")
+
+	errs = validate.StructMask(o, MustCompileFieldMask("*"))
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 11)
+
+	// nil mask validates everything
+	errs = validate.StructMask(o, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 11)
+
+	Equal(t, validate.StructMask(o, MustCompileFieldMask("missing")), nil)
+
+	_, err := CompileFieldMask("items[0")
+	NotEqual(t, err, nil)
+
+	_, err = CompileFieldMask("items..price")
+	NotEqual(t, err, nil)
+
+	PanicMatches(t, func() { MustCompileFieldMask("") }, "validator: empty field mask path")
+	Equal(t, MustCompileFieldMask("a", "b").Paths(), []string{"a", "b"})
+}
+
+func TestDescribe(t *testing.T) {
+	type Inner struct {
+		Name string `json:"name" validate:"required,max=10"`
+	}
+
+	type Node struct {
+		Value    int     `validate:"gte=0"`
+		Children []*Node `validate:"omitempty,dive"`
+	}
+
+	type Outer struct {
+		Color   string            `json:"color" validate:"iscolor"`
+		Contact string            `json:"contact" validate:"email|e164"`
+		Tags    map[string]string `json:"tags" validate:"max=5,dive,keys,alpha,endkeys,required"`
+		Inner   Inner             `json:"inner"`
+		Inners  []*Inner          `json:"inners" validate:"required,dive,required"`
+		Only    *Inner            `json:"only" validate:"structonly"`
+		Node    Node              `json:"node"`
+		When    time.Time         `json:"when" validate:"omitnil"`
+		Skip    string            `validate:"-"`
+	}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
+	var called bool
+	validate.RegisterStructValidation(func(sl StructLevel) { called = true }, Inner{})
+
+	tr := validate.Describe(reflect.TypeOf(&Outer{}))
+	Equal(t, tr.Type, reflect.TypeOf(Outer{}))
+	Equal(t, tr.Name, "Outer")
+	Equal(t, tr.StructLevel, false)
+	Equal(t, tr.StructLevelFunc == nil, true)
+	Equal(t, len(tr.Fields), 8)
+
+	color := tr.Fields[0]
+	Equal(t, color.Name, "Color")
+	Equal(t, color.AltName, "color")
+	Equal(t, color.Index, 0)
+	Equal(t, len(color.Rules), 1)
+	Equal(t, color.Rules[0].Kind, RuleOr)
+	Equal(t, len(color.Rules[0].Alternatives), 6)
+	Equal(t, color.Rules[0].Alternatives[0].Tag, "hexcolor")
+	Equal(t, color.Rules[0].Alternatives[0].Alias, "iscolor")
+
+	contact := tr.Fields[1].Rules
+	Equal(t, len(contact), 1)
+	Equal(t, contact[0].Kind.String(), "or")
+	Equal(t, len(contact[0].Alternatives), 2)
+	Equal(t, contact[0].Alternatives[0].Tag, "email")
+	Equal(t, contact[0].Alternatives[1].Tag, "e164")
+	Equal(t, contact[0].Alternatives[1].Pattern, e164RegexString)
+
+	tags := tr.Fields[2].Rules
+	Equal(t, len(tags), 2)
+	Equal(t, tags[0], Rule{Tag: "max", Param: "5", HasParam: true})
+	Equal(t, tags[1].Kind, RuleDive)
+	Equal(t, tags[1].Keys, []Rule{{Tag: "alpha", Pattern: alphaRegexString}})
+	Equal(t, tags[1].Elem, []Rule{{Tag: "required"}})
+	Equal(t, tags[1].Struct, (*TypeRules)(nil))
+
+	inner := tr.Fields[3]
+	Equal(t, len(inner.Rules), 0)
+	NotEqual(t, inner.Struct, nil)
+	Equal(t, inner.Struct.StructLevel, true)
+	NotEqual(t, inner.Struct.StructLevelFunc, nil)
+
+	inner.Struct.StructLevelFunc(context.Background(), nil)
+	Equal(t, called, true)
+	Equal(t, inner.Struct.Fields[0].Rules, []Rule{{Tag: "required"}, {Tag: "max", Param: "10", HasParam: true}})
+
+	inners := tr.Fields[4]
+	Equal(t, inners.Struct, (*TypeRules)(nil))
+	Equal(t, inners.Rules[1].Struct == inner.Struct, true)
+
+	only := tr.Fields[5]
+	Equal(t, only.Rules, []Rule{{Kind: RuleStructOnly}})
+	Equal(t, only.Struct, (*TypeRules)(nil))
+
+	node := tr.Fields[6].Struct
+	Equal(t, node.Fields[1].Rules[0].Kind, RuleOmitEmpty)
+	Equal(t, node.Fields[1].Rules[1].Struct, node)
+
+	when := tr.Fields[7]
+	Equal(t, when.Rules, []Rule{{Kind: RuleOmitNil}})
+	Equal(t, when.Struct, (*TypeRules)(nil))
+
+	Equal(t, len(validate.Describe(reflect.TypeOf("")).Fields), 0)
+	Equal(t, validate.Describe(nil), TypeRules{})
+	Equal(t, RuleKind(255).String(), "unknown")
+
+	// overridden validations no longer expose the baked in pattern
+	type Code struct {
+		Value string `validate:"alphanum"`
+	}
+
+	Equal(t, validate.Describe(reflect.TypeOf(Code{})).Fields[0].Rules[0].Pattern, alphaNumericRegexString)
+	Equal(t, validate.RegisterValidation("alphanum", func(fl FieldLevel) bool { return true }), nil)
+	Equal(t, validate.Describe(reflect.TypeOf(Code{})).Fields[0].Rules[0].Pattern, "")
+}
+
+func TestValidationInfo(t *testing.T) {
+	validate := New()
+
+	infos := validate.Validations()
+	Equal(t, len(infos), len(validate.validations))
+	Equal(t, sort.SliceIsSorted(infos, func(i, j int) bool { return infos[i].Tag < infos[j].Tag }), true)
+
+	for _, info := range infos {
+		Equal(t, info.BakedIn, true)
+		NotEqual(t, info.Description, "")
+	}
+
+	idx := sort.Search(len(infos), func(i int) bool { return infos[i].Tag >= "min" })
+	Equal(t, infos[idx].Tag, "min")
+	Equal(t, infos[idx].Param.Kind, ParamNumber)
+	Equal(t, infos[idx].Param.Kind.String(), "number")
+	Equal(t, ParamKind(255).String(), "unknown")
+
+	err := validate.RegisterValidationWithInfo("even", func(fl FieldLevel) bool {
+		return fl.Field().Int()%2 == 0
+	}, ValidationInfo{
+		Description: "Even",
+		Kinds:       integerKinds,
+		Param:       ParamSchema{Kind: ParamNone},
+		BakedIn:     true,
+	})
+	Equal(t, err, nil)
+
+	err = validate.RegisterValidationWithInfo("prefix", func(fl FieldLevel) bool {
+		return strings.HasPrefix(fl.Field().String(), fl.Param())
+	}, ValidationInfo{
+		Description: "Prefix",
+		Param: ParamSchema{Kind: ParamString, Parse: func(param string, typ reflect.Type) error {
+			if typ.Kind() != reflect.String {
+				return errors.New("only strings have a prefix")
+			}
+			return nil
+		}},
+	})
+	Equal(t, err, nil)
+
+	infos = validate.Validations()
+	idx = sort.Search(len(infos), func(i int) bool { return infos[i].Tag >= "even" })
+	Equal(t, infos[idx], ValidationInfo{Tag: "even", Description: "Even", Kinds: integerKinds, Param: ParamSchema{Kind: ParamNone}})
+
+	// overriding a baked in validation without metadata keeps only the tag
+	Equal(t, validate.RegisterValidation("alpha", func(fl FieldLevel) bool { return true }), nil)
+	infos = validate.Validations()
+	idx = sort.Search(len(infos), func(i int) bool { return infos[i].Tag >= "alpha" })
+	Equal(t, infos[idx], ValidationInfo{Tag: "alpha"})
+
+	// without strict checking the parameters of the baked in validations are still
+	// checked when cached
+	type BadMin struct {
+		Name string `validate:"min=abc"`
+	}
+
+	PanicMatches(t, func() { _ = validate.Struct(BadMin{}) }, "Invalid parameter 'abc' for validation 'min' on field 'Name': strconv.ParseInt: parsing \"abc\": invalid syntax")
+
+	strict := New(WithStrictTags())
+	Equal(t, strict.RegisterValidationWithInfo("even", func(fl FieldLevel) bool {
+		return fl.Field().Int()%2 == 0
+	}, ValidationInfo{Kinds: integerKinds, Param: ParamSchema{Kind: ParamNone}}), nil)
+	Equal(t, strict.RegisterValidationWithInfo("prefix", func(fl FieldLevel) bool {
+		return strings.HasPrefix(fl.Field().String(), fl.Param())
+	}, ValidationInfo{Param: ParamSchema{Parse: func(param string, typ reflect.Type) error {
+		if typ.Kind() != reflect.String {
+			return errors.New("only strings have a prefix")
+		}
+		return nil
+	}}}), nil)
+
+	PanicMatches(t, func() { _ = strict.Struct(BadMin{}) }, `Invalid parameter 'abc' for validation 'min' on field 'Name': strconv.ParseInt: parsing "abc": invalid syntax`)
+
+	type BadLen struct {
+		Active bool `validate:"len=1"`
+	}
+
+	PanicMatches(t, func() { _ = strict.Struct(BadLen{}) }, "Validation 'len' cannot be used on field 'Active' of kind bool")
+
+	type BadRequired struct {
+		Name string `validate:"required=1"`
+	}
+
+	PanicMatches(t, func() { _ = strict.Struct(BadRequired{}) }, "Invalid parameter '1' for validation 'required' on field 'Name': takes no parameter")
+
+	type BadOneOf struct {
+		Level int `validate:"oneof=1 2 high"`
+	}
+
+	PanicMatches(t, func() { _ = strict.Struct(BadOneOf{}) }, `Invalid parameter '1 2 high' for validation 'oneof' on field 'Level': strconv.ParseInt: parsing "high": invalid syntax`)
+
+	type BadDive struct {
+		Counts map[string]uint `validate:"dive,keys,alpha,endkeys,max=-1"`
+	}
+
+	PanicMatches(t, func() { _ = strict.Struct(BadDive{}) }, `Invalid parameter '-1' for validation 'max' on field 'Counts': strconv.ParseUint: parsing "-1": invalid syntax`)
+
+	type BadKeys struct {
+		Flags map[bool]uint `validate:"dive,keys,len=1,endkeys"`
+	}
+
+	PanicMatches(t, func() { _ = strict.Struct(BadKeys{}) }, "Validation 'len' cannot be used on field 'Flags' of kind bool")
+
+	type BadCustom struct {
+		Name  string `validate:"even"`
+		Count int    `validate:"prefix=a"`
+	}
+
+	PanicMatches(t, func() { _ = strict.Struct(BadCustom{}) }, "Validation 'even' cannot be used on field 'Name' of kind string")
+
+	type BadParse struct {
+		Count int `validate:"prefix=a"`
+	}
+
+	PanicMatches(t, func() { _ = strict.Struct(BadParse{}) }, "Invalid parameter 'a' for validation 'prefix' on field 'Count': only strings have a prefix")
+
+	type Valid struct {
+		Name     string            `validate:"required,min=2,max=10,prefix=ab"`
+		Count    int               `validate:"even,gte=0,lt=100"`
+		Level    string            `validate:"oneof=low 'very high'"`
+		Timeout  time.Duration     `validate:"min=1s,max=3600000000000"`
+		Created  time.Time         `validate:"lte"`
+		Labels   map[string]string `validate:"max=5,dive,keys,alpha,endkeys,required"`
+		Tags     []string          `validate:"dive,alpha|numeric"`
+		Ratio    *float64          `validate:"omitempty,gt=0.5"`
+		Password string
+		Confirm  string `validate:"eqfield=Password"`
+	}
+
+	// validations taking a param which isn't checked beyond being present
+	type Upload struct {
+		Type string `validate:"mimetype=image/png"`
+	}
+
+	_ = strict.Struct(Upload{})
+
+	type BadUpload struct {
+		Type string `validate:"mimetype"`
+	}
+
+	PanicMatches(t, func() { _ = strict.Struct(BadUpload{}) }, "Invalid parameter '' for validation 'mimetype' on field 'Type': requires a parameter")
+
+	// tags passed to Var and those of interface fields are checked when validating
+	PanicMatches(t, func() { _ = strict.Var("abc", "min=abc") }, `strconv.ParseInt: parsing "abc": invalid syntax`)
+	PanicMatches(t, func() { _ = strict.Var("abc", "required=1") }, "takes no parameter")
+	PanicMatches(t, func() { _ = strict.VarString("abc", "min=abc") }, `strconv.ParseInt: parsing "abc": invalid syntax`)
+	PanicMatches(t, func() { _ = strict.Var([]interface{}{"a", 1}, "dive,oneof=a b") }, `strconv.ParseInt: parsing "a": invalid syntax`)
+
+	Equal(t, strict.Var("abc", "min=2"), nil)
+	Equal(t, strict.Var(1.5, "min=2").(ValidationErrors)[0].Tag(), "min")
+
+	type Any struct {
+		Value interface{} `validate:"max=10"`
+	}
+
+	Equal(t, strict.Struct(Any{Value: "abc"}), nil)
+	PanicMatches(t, func() { _ = strict.Struct(Any{Value: true}) }, `strconv.ParseBool: parsing "10": invalid syntax`)
+
+	ratio := 0.75
+	s := Valid{
+		Name:    "abc",
+		Count:   2,
+		Level:   "very high",
+		Timeout: time.Second,
+		Labels:  map[string]string{"a": "b"},
+		Tags:    []string{"a", "1"},
+		Ratio:   &ratio,
+	}
+	Equal(t, strict.Struct(s), nil)
+}
+
+func TestParsedParam(t *testing.T) {
+	p := newParam("0x10")
+	Equal(t, p.String(), "0x10")
+
+	i, err := p.Int()
+	Equal(t, err, nil)
+	Equal(t, i, int64(16))
+
+	u, err := p.Uint()
+	Equal(t, err, nil)
+	Equal(t, u, uint64(16))
+
+	_, err = p.Bool()
+	NotEqual(t, err, nil)
+
+	p = newParam("1.5")
+
+	f, err := p.Float64()
+	Equal(t, err, nil)
+	Equal(t, f, 1.5)
+
+	f, err = p.Float32()
+	Equal(t, err, nil)
+	Equal(t, f, 1.5)
+
+	_, err = p.Int()
+	Equal(t, err.Error(), `strconv.ParseInt: parsing "1.5": invalid syntax`)
+
+	d, err := newParam("1h30m").Duration()
+	Equal(t, err, nil)
+	Equal(t, d, 90*time.Minute)
+
+	d, err = newParam("1000").Duration()
+	Equal(t, err, nil)
+	Equal(t, d, time.Microsecond)
+
+	_, err = newParam("1 hour").Duration()
+	Equal(t, err.Error(), `strconv.ParseInt: parsing "1 hour": invalid syntax`)
+
+	p = newParam("red 'light blue' green")
+	Equal(t, p.Values(), []string{"red", "light blue", "green"})
+	Equal(t, p.Contains("light blue"), true)
+	Equal(t, p.Contains("blue"), false)
+
+	p = newParam("red")
+	Equal(t, p.Values(), []string{"red"})
+	Equal(t, p.Contains("red"), true)
+	Equal(t, p.Contains("blue"), false)
+
+	p = newParam("")
+	Equal(t, p == emptyParam, true)
+	Equal(t, len(p.Values()), 0)
+	Equal(t, p.Contains(""), false)
+
+	validate := New()
+	Equal(t, validate.RegisterValidation("multiple", func(fl FieldLevel) bool {
+		n, err := ParsedParam(fl).Int()
+		if err != nil {
+			panic(err.Error())
+		}
+		return fl.Field().Int()%n == 0
+	}), nil)
+
+	type Order struct {
+		Quantity int `validate:"multiple=6,oneof=6 12 24"`
+	}
+
+	Equal(t, validate.Struct(Order{Quantity: 12}), nil)
+
+	errs := validate.Struct(Order{Quantity: 8})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Order.Quantity", "Order.Quantity", "Quantity", "Quantity", "multiple")
+
+	errs = validate.Struct(Order{Quantity: 18})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Order.Quantity", "Order.Quantity", "Quantity", "Quantity", "oneof")
+
+	// the parameter is parsed once and shared by every validation of the tag
+	var params []*Param
+	Equal(t, validate.RegisterValidation("capture", func(fl FieldLevel) bool {
+		params = append(params, ParsedParam(fl))
+		return true
+	}), nil)
+
+	Equal(t, validate.Var(1, "capture=5"), nil)
+	Equal(t, validate.Var(2, "capture=5"), nil)
+	Equal(t, len(params), 2)
+	Equal(t, params[0] == params[1], true)
+}
+
+func TestStructInto(t *testing.T) {
+	type Inner struct {
+		Name string `validate:"required"`
+	}
+
+	type Test struct {
+		Email string   `json:"email" validate:"required,email"`
+		Age   int      `json:"age" validate:"gte=18|eq=0"`
+		Inner Inner    `json:"inner"`
+		Tags  []string `json:"tags" validate:"dive,alpha"`
+	}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("json")
+	})
+
+	var r Result
+	Equal(t, r.Valid(), true)
+
+	invalid := Test{Email: "a", Age: 5, Tags: []string{"a", "1"}}
+
+	err := validate.StructInto(context.Background(), invalid, &r)
+	NotEqual(t, err, nil)
+	Equal(t, r.Valid(), false)
+	Equal(t, err, error(r.Errors()))
+
+	expected := validate.Struct(invalid).(ValidationErrors)
+	errs := r.Errors()
+	Equal(t, len(errs), 4)
+	Equal(t, len(errs), len(expected))
+
+	for i, fe := range errs {
+		Equal(t, fe.Namespace(), expected[i].Namespace())
+		Equal(t, fe.StructNamespace(), expected[i].StructNamespace())
+		Equal(t, fe.Field(), expected[i].Field())
+		Equal(t, fe.StructField(), expected[i].StructField())
+		Equal(t, fe.Tag(), expected[i].Tag())
+		Equal(t, fe.Value(), expected[i].Value())
+	}
+
+	AssertError(t, errs, "Test.email", "Test.Email", "email", "Email", "email")
+	AssertError(t, errs, "Test.age", "Test.Age", "age", "Age", "gte=18|eq=0")
+	AssertError(t, errs, "Test.inner.Name", "Test.Inner.Name", "Name", "Name", "required")
+	AssertError(t, errs, "Test.tags[1]", "Test.Tags[1]", "tags[1]", "Tags[1]", "alpha")
+
+	valid := Test{Email: "a@b.co", Inner: Inner{Name: "x"}}
+
+	Equal(t, validate.StructInto(context.Background(), &valid, &r), nil)
+	Equal(t, r.Valid(), true)
+	Equal(t, r.Errors(), nil)
+
+	err = validate.StructInto(context.Background(), "", &r)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: (nil string)")
+
+	// a Result is reused, see BenchmarkStructIntoSimpleSuccess for allocations
+	type Simple struct {
+		Name  string `validate:"required,alpha"`
+		Age   int    `validate:"gte=18|eq=0"`
+		Inner Inner
+	}
+
+	simple := Simple{Name: "1", Age: 1}
+
+	_ = validate.StructInto(context.Background(), &simple, &r)
+	Equal(t, len(r.Errors()), 3)
+
+	// namespaces of earlier runs are not overwritten by reusing the Result
+	ns, structNs := r.Errors()[0].Namespace(), r.Errors()[0].StructNamespace()
+	_ = validate.StructInto(context.Background(), &Simple{Name: "abc", Age: 1}, &r)
+	Equal(t, ns, "Simple.Name")
+	Equal(t, structNs, "Simple.Name")
+	Equal(t, r.Errors()[0].Namespace(), "Simple.Age")
+
+	simple = Simple{Name: "abc", Inner: Inner{Name: "x"}}
+
+	Equal(t, validate.StructInto(context.Background(), &simple, &r), nil)
+	Equal(t, r.Errors(), nil)
+
+	// errors not validated into a Result remain valid after validating again
+	err = validate.Struct(invalid)
+	Equal(t, validate.Struct(Test{Email: "b", Age: 6, Inner: Inner{Name: "x"}}) != nil, true)
+	AssertError(t, err.(ValidationErrors), "Test.email", "Test.Email", "email", "Email", "email")
+	AssertError(t, err.(ValidationErrors), "Test.tags[1]", "Test.Tags[1]", "tags[1]", "Tags[1]", "alpha")
+
+	r.Reset()
+	Equal(t, r.Valid(), true)
+}
+
+func TestVarTyped(t *testing.T) {
+	validate := New()
+
+	Equal(t, validate.VarString("a@b.co", "required,email"), nil)
+	Equal(t, validate.VarString("", ""), nil)
+
+	errs := validate.VarString("a", "required,email")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "email")
+
+	fe := errs.(ValidationErrors)[0]
+	Equal(t, fe.Value(), "a")
+	Equal(t, fe.Kind(), reflect.String)
+
+	Equal(t, validate.VarInt(5, "gte=1,lte=10"), nil)
+	Equal(t, validate.VarInt(5, "-"), nil)
+
+	errs = validate.VarInt(11, "gte=1,lte=10")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "lte")
+	Equal(t, errs.(ValidationErrors)[0].Value(), int64(11))
+
+	Equal(t, validate.VarFloat(0.5, "gt=0,lt=1"), nil)
+	Equal(t, validate.VarFloat(0.5, ""), nil)
+
+	errs = validate.VarFloat(1.5, "gt=0,lt=1")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "lt")
+	Equal(t, errs.(ValidationErrors)[0].Value(), 1.5)
+
+	Equal(t, validate.VarStringCtx(context.Background(), "abc", "alpha"), nil)
+	Equal(t, validate.VarIntCtx(context.Background(), 2, "oneof=1 2"), nil)
+	Equal(t, validate.VarFloatCtx(context.Background(), 2, "eq=2"), nil)
+}
+
+func TestCacheStats(t *testing.T) {
+	validate := New()
+
+	Equal(t, validate.CacheStats(), CacheStats{})
+
+	type Inner struct {
+		Name string `validate:"required"`
+	}
+
+	type Outer struct {
+		Inner Inner
+		Age   int `validate:"gte=0"`
+	}
+
+	Equal(t, validate.Struct(Outer{Inner: Inner{Name: "a"}}), nil)
+	Equal(t, validate.Struct(&Outer{Inner: Inner{Name: "a"}}), nil)
+	Equal(t, validate.Var(1, "gte=0"), nil)
+	Equal(t, validate.Var(2, "gte=0"), nil)
+	Equal(t, validate.Var(3, "lte=5"), nil)
+
+	Equal(t, validate.CacheStats(), CacheStats{Structs: 2, StructMisses: 2, Tags: 2, TagMisses: 2})
+
+	// struct types built at runtime to fill the cache
+	for i := 0; i < 2000; i++ {
+		typ := reflect.StructOf([]reflect.StructField{{
+			Name: "Field" + strconv.Itoa(i),
+			Type: reflect.TypeOf(""),
+			Tag:  `validate:"required"`,
+		}})
+
+		errs := validate.Struct(reflect.New(typ).Interface())
+		NotEqual(t, errs, nil)
+		Equal(t, errs.(ValidationErrors)[0].Field(), "Field"+strconv.Itoa(i))
+	}
+
+	stats := validate.CacheStats()
+	Equal(t, stats.Structs, 2002)
+	Equal(t, stats.StructMisses, uint64(2002))
+}
+
+func TestTagCacheSize(t *testing.T) {
+	validate := New(WithTagCacheSize(3))
+
+	for i := 0; i < 10; i++ {
+		Equal(t, validate.Var(i, "gte=0,lte="+strconv.Itoa(i)), nil)
+	}
+
+	stats := validate.CacheStats()
+	Equal(t, stats.Tags, 3)
+	Equal(t, stats.TagMisses, uint64(10))
+	Equal(t, stats.TagEvictions, uint64(7))
+
+	// recently used tags are kept
+	validate = New(WithTagCacheSize(2))
+	Equal(t, validate.Var(1, "gte=1"), nil)
+	Equal(t, validate.Var(1, "gte=0"), nil)
+	Equal(t, validate.Var(1, "gte=1"), nil)
+	Equal(t, validate.Var(1, "lte=1"), nil)
+	Equal(t, validate.Var(1, "lte=2"), nil)
+	Equal(t, validate.Var(1, "lte=1"), nil)
+
+	_, found := validate.tagCache.Get("gte=0")
+	Equal(t, found, false)
+	_, found = validate.tagCache.Get("lte=1")
+	Equal(t, found, true)
+
+	stats = validate.CacheStats()
+	Equal(t, stats.Tags, 2)
+	Equal(t, stats.TagMisses, uint64(4))
+	Equal(t, stats.TagEvictions, uint64(2))
+
+	// unbounded when not positive
+	validate = New(WithTagCacheSize(0))
+	for i := 0; i < 10; i++ {
+		Equal(t, validate.Var(i, "gte=0,lte="+strconv.Itoa(i)), nil)
+	}
+	Equal(t, validate.CacheStats().Tags, 10)
+
+	// concurrent use with evictions
+	validate = New(WithTagCacheSize(8))
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				n := (i * (g + 1)) % 32
+				if err := validate.Var(n, "gte=0,lte="+strconv.Itoa(n)); err != nil {
+					t.Error(err)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	stats = validate.CacheStats()
+	Equal(t, stats.Tags, 8)
+	Equal(t, stats.TagMisses-stats.TagEvictions, uint64(8))
+}
+
+func TestClone(t *testing.T) {
+	type Inner struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	type Test struct {
+		Code  string `json:"code" validate:"code"`
+		Level string `json:"level" validate:"level"`
+		Inner Inner  `json:"inner" validate:"required"`
+	}
+
+	validate := New(WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("json")
+	})
+	validate.RegisterAlias("level", "oneof=low high")
+	Equal(t, validate.RegisterValidation("code", func(fl FieldLevel) bool {
+		return strings.HasPrefix(fl.Field().String(), "A")
+	}), nil)
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		if sl.Current().Interface().(Inner).Name == "bad" {
+			sl.ReportError(sl.Current().Field(0).Interface(), "name", "Name", "notbad", "")
+		}
+	}, Inner{})
+
+	clone := validate.Clone(WithStrictTags())
+
+	s := Test{Code: "B1", Level: "mid", Inner: Inner{Name: "bad"}}
+
+	for _, v := range []*Validate{validate, clone} {
+		errs := v.Struct(s)
+		NotEqual(t, errs, nil)
+		AssertError(t, errs, "Test.code", "Test.Code", "code", "Code", "code")
+		AssertError(t, errs, "Test.level", "Test.Level", "level", "Level", "level")
+		AssertError(t, errs, "Test.inner.name", "Test.Inner.Name", "name", "Name", "notbad")
+	}
+
+	// options are applied to the clone only
+	Equal(t, clone.strictTags, true)
+	Equal(t, validate.strictTags, false)
+	Equal(t, clone.requiredStructEnabled, true)
+
+	// registrations on the clone don't affect the original
+	Equal(t, clone.RegisterValidation("code", func(fl FieldLevel) bool { return true }), nil)
+	clone.RegisterAlias("level", "oneof=low mid high")
+
+	errs := clone.Struct(s)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Test.inner.name", "Test.Inner.Name", "name", "Name", "notbad")
+
+	errs = validate.Struct(s)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+
+	// nor the other way around
+	Equal(t, validate.RegisterValidation("even", func(fl FieldLevel) bool { return true }), nil)
+	PanicMatches(t, func() { _ = clone.Var(1, "even") }, "Undefined validation function 'even' on field ''")
+
+	// clones have their own caches
+	Equal(t, clone.CacheStats().Structs, 2)
+	Equal(t, validate.Clone().CacheStats(), CacheStats{})
+}
+
+func TestRegistrationClearsCaches(t *testing.T) {
+	type Test struct {
+		Code string `validate:"code"`
+	}
+
+	validate := New()
+	Equal(t, validate.RegisterValidation("code", func(fl FieldLevel) bool { return true }), nil)
+
+	Equal(t, validate.Struct(Test{Code: "B"}), nil)
+	Equal(t, validate.Var("B", "code"), nil)
+
+	stats := validate.CacheStats()
+	Equal(t, stats.Structs, 1)
+	Equal(t, stats.Tags, 1)
+
+	// re-registering replaces the function of cached tags
+	Equal(t, validate.RegisterValidation("code", func(fl FieldLevel) bool {
+		return fl.Field().String() == "A"
+	}), nil)
+
+	stats = validate.CacheStats()
+	Equal(t, stats.Structs, 0)
+	Equal(t, stats.Tags, 0)
+
+	errs := validate.Struct(Test{Code: "B"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Code", "Test.Code", "Code", "Code", "code")
+	NotEqual(t, validate.Var("B", "code"), nil)
+
+	// as do the other registrations
+	validate.RegisterAlias("alpha2", "alpha,len=2")
+	Equal(t, validate.CacheStats().Structs, 0)
+
+	Equal(t, validate.Struct(Test{Code: "A"}), nil)
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(sl.Current().Field(0).Interface(), "Code", "Code", "never", "")
+	}, Test{})
+
+	errs = validate.Struct(Test{Code: "A"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Code", "Test.Code", "Code", "Code", "never")
+
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.ToLower(fld.Name)
+	})
+
+	errs = validate.Struct(Test{Code: "A"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Code", "Test.Code", "Code", "Code", "never")
+
+	validate.SetTagName("v")
+	Equal(t, validate.CacheStats().Structs, 0)
+
+	// a clone can be configured while the original is in use
+	validate = New()
+	Equal(t, validate.RegisterValidation("code", func(fl FieldLevel) bool {
+		return fl.Field().String() == "A"
+	}), nil)
+
+	var wg sync.WaitGroup
+	started := make(chan struct{})
+	done := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		close(started)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				if err := validate.Struct(Test{Code: "A"}); err != nil {
+					t.Error(err)
+				}
+			}
+		}
+	}()
+
+	<-started
+	for i := 0; i < 10; i++ {
+		clone := validate.Clone()
+		Equal(t, clone.RegisterValidation("code", func(fl FieldLevel) bool { return true }), nil)
+		Equal(t, clone.Struct(Test{Code: "B"}), nil)
+	}
+
+	close(done)
+	wg.Wait()
+}
+
+type recordingObserver struct {
+	mu          sync.Mutex
+	structs     []StructEvent
+	validations []ValidationEvent
+	structLevel []StructLevelEvent
+}
+
+func (o *recordingObserver) ObserveStruct(_ context.Context, e StructEvent) {
+	o.mu.Lock()
+	o.structs = append(o.structs, e)
+	o.mu.Unlock()
+}
+
+func (o *recordingObserver) ObserveValidation(_ context.Context, e ValidationEvent) {
+	o.mu.Lock()
+	o.validations = append(o.validations, e)
+	o.mu.Unlock()
+}
+
+func (o *recordingObserver) ObserveStructLevel(_ context.Context, e StructLevelEvent) {
+	o.mu.Lock()
+	o.structLevel = append(o.structLevel, e)
+	o.mu.Unlock()
+}
+
+func TestObserver(t *testing.T) {
+	type Address struct {
+		City string `json:"city" validate:"required,slow"`
+	}
+
+	type User struct {
+		Name    string    `json:"name" validate:"required,min=2"`
+		Color   string    `json:"color" validate:"iscolor"`
+		Age     int       `json:"age" validate:"gte=18|eq=0"`
+		Address Address   `json:"address"`
+		Tags    []string  `json:"tags" validate:"dive,alpha"`
+		Born    time.Time `json:"born"`
+	}
+
+	o := new(recordingObserver)
+
+	validate := New(WithObserver(o))
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("json")
+	})
+	Equal(t, validate.RegisterValidationCtx("slow", func(ctx context.Context, fl FieldLevel) bool {
+		time.Sleep(5 * time.Millisecond)
+		return fl.Field().String() != "nowhere"
+	}), nil)
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(sl.Current().Field(0).Interface(), "city", "City", "custom", "")
+	}, Address{})
+
+	u := User{Name: "a", Color: "#fff", Age: 20, Address: Address{City: "nowhere"}, Tags: []string{"a", "1"}}
+
+	errs := validate.StructCtx(context.Background(), u)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 4)
+
+	type call struct {
+		Tag, Alias, Param, Namespace string
+		Valid                        bool
+	}
+
+	calls := make([]call, len(o.validations))
+	for i, e := range o.validations {
+		calls[i] = call{e.Tag, e.Alias, e.Param, e.Namespace, e.Valid}
+		Equal(t, e.Start.IsZero(), false)
+	}
+
+	Equal(t, calls, []call{
+		{"required", "", "", "User.name", true},
+		{"min", "", "2", "User.name", false},
+		{"hexcolor", "iscolor", "", "User.color", true},
+		{"gte", "", "18", "User.age", true},
+		{"required", "", "", "User.address.city", true},
+		{"slow", "", "", "User.address.city", false},
+		{"alpha", "", "", "User.tags[0]", true},
+		{"alpha", "", "", "User.tags[1]", false},
+	})
+	Equal(t, o.validations[5].Duration >= 5*time.Millisecond, true)
+
+	Equal(t, len(o.structLevel), 1)
+	Equal(t, o.structLevel[0].Type == reflect.TypeOf(Address{}), true)
+	Equal(t, o.structLevel[0].Namespace, "User.address")
+	Equal(t, o.structLevel[0].Errors, 1)
+
+	Equal(t, len(o.structs), 2)
+	Equal(t, o.structs[0].Type == reflect.TypeOf(Address{}), true)
+	Equal(t, o.structs[0].Namespace, "User.address")
+	Equal(t, o.structs[0].Errors, 2)
+	Equal(t, o.structs[1].Type == reflect.TypeOf(User{}), true)
+	Equal(t, o.structs[1].Namespace, "User")
+	Equal(t, o.structs[1].Errors, 4)
+	Equal(t, o.structs[1].Duration >= o.structs[0].Duration, true)
+
+	// variables and clones
+	o = new(recordingObserver)
+	validate = New(WithObserver(o)).Clone()
+
+	Equal(t, validate.Var(5, "gt=1"), nil)
+	Equal(t, validate.VarWithKey("count", 0, "gt=1") != nil, true)
+
+	Equal(t, len(o.structs), 0)
+	Equal(t, len(o.validations), 2)
+	Equal(t, o.validations[0].Namespace, "")
+	Equal(t, o.validations[0].Valid, true)
+	Equal(t, o.validations[1].Namespace, "count")
+	Equal(t, o.validations[1].Valid, false)
+}
+
+func TestExplain(t *testing.T) {
+	type Item struct {
+		Kind  string `json:"kind" validate:"oneof=digital physical"`
+		Depot string `json:"depot" validate:"required_if=Kind physical"`
+	}
+
+	type Order struct {
+		ID    string  `json:"id" validate:"uuid|numeric"`
+		Note  string  `json:"note" validate:"omitempty,max=5"`
+		Items []*Item `json:"items" validate:"required,dive"`
+	}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("json")
+	})
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		if len(sl.Current().Interface().(Order).Items) > 1 {
+			sl.ReportError(nil, "items", "Items", "single", "")
+		}
+	}, Order{})
+
+	o := Order{
+		ID:    "42",
+		Items: []*Item{{Kind: "digital"}, {Kind: "physical"}},
+	}
+
+	trace, err := validate.Explain(o)
+	NotEqual(t, err, nil)
+
+	errs := err.(ValidationErrors)
+	Equal(t, len(errs), 2)
+	AssertError(t, errs, "Order.items[1].depot", "Order.Items[1].Depot", "depot", "Depot", "required_if")
+	AssertError(t, errs, "Order.items", "Order.Items", "items", "Items", "single")
+
+	Equal(t, trace.String(), `Order.id
+  | uuid: failed
+  | numeric: passed
+Order.note
+  omitempty: skipped remaining rules
+Order.items
+  required: passed
+Order.items[0]
+Order.items[0].kind
+  oneof=digital physical: passed
+Order.items[0].depot
+  required_if=Kind physical: skipped, condition not met
+Order.items[1]
+Order.items[1].kind
+  oneof=digital physical: passed
+Order.items[1].depot
+  required_if=Kind physical: failed
+struct level Order: 1 errors
+`)
+
+	step := trace.Steps[len(trace.Steps)-1]
+	Equal(t, step.Kind, TraceStructLevel)
+	Equal(t, step.Type == reflect.TypeOf(Order{}), true)
+	Equal(t, step.Errors, 1)
+
+	step = trace.Steps[1]
+	Equal(t, step.Kind, TraceOr)
+	Equal(t, step.Kind.String(), "or")
+	Equal(t, step.Namespace, "Order.id")
+	Equal(t, step.Tag, "uuid")
+	Equal(t, step.Passed, false)
+
+	// aliases, nil pointers and the trace not leaking into other calls
+	type Nested struct {
+		Color string  `validate:"iscolor"`
+		Ptr   *string `validate:"omitnil,min=1"`
+	}
+
+	trace, err = validate.Explain(&Nested{Color: "#fff"})
+	Equal(t, err, nil)
+	Equal(t, trace.String(), `Nested.Color
+  | hexcolor (iscolor): passed
+Nested.Ptr
+  omitnil: skipped remaining rules
+`)
+	Equal(t, validate.Struct(&Nested{Color: "#fff"}), nil)
+
+	_, err = validate.Explain(1)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: (nil int)")
+}
+
+func TestErrorOrder(t *testing.T) {
+	type Item struct {
+		Name string `validate:"required"`
+	}
+
+	type Order struct {
+		Email  string         `validate:"required,email"`
+		Items  []Item         `validate:"dive"`
+		Counts map[int]int    `validate:"dive,min=1"`
+		Labels map[string]int `validate:"dive,max=1"`
+		Code   string         `validate:"len=3"`
+	}
+
+	validate := New()
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(nil, "Email", "Email", "unique", "")
+		sl.ReportError(nil, "Items[1].Name", "Items[1].Name", "unique", "")
+	}, Order{})
+
+	o := Order{
+		Items:  make([]Item, 12),
+		Counts: map[int]int{},
+		Labels: map[string]int{},
+		Code:   "x",
+	}
+	for i := 0; i < 12; i++ {
+		o.Counts[i*7%12] = 0
+		o.Labels[string(rune('a'+i*5%12))] = 2
+	}
+
+	// map values are validated in map order, sorting returning the same order
+	for _, by := range []SortOrder{SortByField, SortByNamespace, SortByTag} {
+		var expected []string
+
+		for i := 0; i < 20; i++ {
+			errs := validate.Struct(o).(ValidationErrors)
+			errs.Sort(by)
+
+			var ns []string
+			for _, fe := range errs {
+				ns = append(ns, fe.Namespace()+" "+fe.Tag())
+			}
+
+			if expected == nil {
+				expected = ns
+			}
+			Equal(t, ns, expected)
+		}
+	}
+
+	errs := validate.Struct(o).(ValidationErrors)
+	Equal(t, len(errs), 40)
+	Equal(t, errs[0].Namespace()+" "+errs[0].Tag(), "Order.Email required")
+	Equal(t, errs[1].Namespace()+" "+errs[1].Tag(), "Order.Items[0].Name required")
+	Equal(t, errs[37].Namespace()+" "+errs[37].Tag(), "Order.Code len")
+	Equal(t, errs[38].Namespace()+" "+errs[38].Tag(), "Order.Email unique")
+	Equal(t, errs[39].Namespace()+" "+errs[39].Tag(), "Order.Items[1].Name unique")
+
+	errs.Sort(SortByField)
+	Equal(t, errs[0].Namespace()+" "+errs[0].Tag(), "Order.Email required")
+	Equal(t, errs[1].Namespace()+" "+errs[1].Tag(), "Order.Email unique")
+	// placed with the Items field, before its elements
+	Equal(t, errs[2].Namespace()+" "+errs[2].Tag(), "Order.Items[1].Name unique")
+	Equal(t, errs[3].Namespace(), "Order.Items[0].Name")
+	Equal(t, errs[4].Namespace()+" "+errs[4].Tag(), "Order.Items[1].Name required")
+	// map values ordered by key
+	Equal(t, errs[15].Namespace(), "Order.Counts[0]")
+	Equal(t, errs[16].Namespace(), "Order.Counts[1]")
+	Equal(t, errs[26].Namespace(), "Order.Counts[11]")
+	Equal(t, errs[27].Namespace(), "Order.Labels[a]")
+	Equal(t, errs[38].Namespace(), "Order.Labels[l]")
+	Equal(t, errs[39].Namespace(), "Order.Code")
+
+	errs.Sort(SortByNamespace)
+	Equal(t, errs[0].Namespace(), "Order.Code")
+	Equal(t, errs[1].Namespace(), "Order.Counts[0]")
+	Equal(t, errs[3].Namespace(), "Order.Counts[2]")
+	Equal(t, errs[12].Namespace(), "Order.Counts[11]")
+	Equal(t, errs[13].Namespace()+" "+errs[13].Tag(), "Order.Email required")
+	Equal(t, errs[14].Namespace()+" "+errs[14].Tag(), "Order.Email unique")
+	// equal namespaces keep their order by field
+	Equal(t, errs[16].Namespace()+" "+errs[16].Tag(), "Order.Items[1].Name unique")
+	Equal(t, errs[17].Namespace()+" "+errs[17].Tag(), "Order.Items[1].Name required")
+	Equal(t, errs[18].Namespace(), "Order.Items[2].Name")
+	Equal(t, errs[26].Namespace(), "Order.Items[10].Name")
+
+	errs.Sort(SortByTag)
+	Equal(t, errs[0].Tag(), "len")
+	Equal(t, errs[1].Tag(), "max")
+	Equal(t, errs[1].Namespace(), "Order.Labels[a]")
+	Equal(t, errs[13].Tag(), "min")
+	Equal(t, errs[13].Namespace(), "Order.Counts[0]")
+	Equal(t, errs[25].Namespace(), "Order.Email")
+	Equal(t, errs[25].Tag(), "required")
+	Equal(t, errs[38].Tag(), "unique")
+	Equal(t, errs[38].Namespace(), "Order.Email")
+
+	Equal(t, compareNamespaces("a[2]", "a[10]"), -1)
+	Equal(t, compareNamespaces("a[010]", "a[10]"), 0)
+	Equal(t, compareNamespaces("a.b", "a"), 1)
+	Equal(t, compareNamespaces("a[1].c", "a[1].b"), 1)
+}
+
+func TestTranslationFallback(t *testing.T) {
+	uni := ut.New(en.New(), en.New(), fr.New(), nl.New())
+
+	enTrans, _ := uni.GetTranslator("en")
+	frTrans, _ := uni.GetTranslator("fr")
+	nlTrans, _ := uni.GetTranslator("nl")
+
+	register := func(v *Validate, tag string, trans ut.Translator, text string) {
+		err := v.RegisterTranslation(tag, trans,
+			func(ut ut.Translator) error {
+				return ut.Add(tag, text, false)
+			}, func(ut ut.Translator, fe FieldError) string {
+				s, _ := ut.T(fe.Tag(), fe.Field())
+				return s
+			})
+		Equal(t, err, nil)
+	}
+
+	validate := New()
+	register(validate, "required", enTrans, "{0} is a required field")
+	register(validate, "min", enTrans, "{0} is too short")
+	register(validate, "max", enTrans, "{0} is too long")
+	register(validate, "required", frTrans, "{0} est un champ obligatoire")
+	register(validate, "min", frTrans, "{0} est trop court")
+	register(validate, "required", nlTrans, "{0} is een verplicht veld")
+
+	type Test struct {
+		Name  string `validate:"required"`
+		Code  string `validate:"min=5"`
+		Label string `validate:"max=1"`
+		Email string `validate:"omitempty,email"`
+	}
+
+	errs := validate.Struct(Test{Code: "a", Label: "ab", Email: "x"}).(ValidationErrors)
+	Equal(t, len(errs), 4)
+
+	// without fallbacks missing translations return the error
+	Equal(t, errs[1].Translate(nlTrans), "Key: 'Test.Code' Error:Field validation for 'Code' failed on the 'min' tag")
+
+	validate.RegisterTranslationFallback(nlTrans, frTrans, enTrans)
+
+	Equal(t, errs[0].Translate(nlTrans), "Name is een verplicht veld")
+	Equal(t, errs[1].Translate(nlTrans), "Code est trop court")
+	Equal(t, errs[2].Translate(nlTrans), "Label is too long")
+	Equal(t, errs[3].Translate(nlTrans), "Key: 'Test.Email' Error:Field validation for 'Email' failed on the 'email' tag")
+
+	// without fallbacks, a parent locale or WithTranslators the error is returned
+	Equal(t, errs[2].Translate(frTrans), "Key: 'Test.Label' Error:Field validation for 'Label' failed on the 'max' tag")
+
+	// fallbacks are followed transitively, ending should they form a cycle
+	validate.RegisterTranslationFallback(frTrans, enTrans)
+	validate.RegisterTranslationFallback(nlTrans, frTrans)
+	validate.RegisterTranslationFallback(enTrans, nlTrans)
+	Equal(t, errs[2].Translate(frTrans), "Label is too long")
+	Equal(t, errs[2].Translate(nlTrans), "Label is too long")
+	Equal(t, errs[3].Translate(nlTrans), "Key: 'Test.Email' Error:Field validation for 'Email' failed on the 'email' tag")
+
+	// translators of a registered locale from another UniversalTranslator
+	uni2 := ut.New(nl.New(), nl.New())
+	nlTrans2, _ := uni2.GetTranslator("nl")
+	Equal(t, errs[0].Translate(nlTrans2), "Name is een verplicht veld")
+	Equal(t, errs[2].Translate(nlTrans2), "Label is too long")
+
+	terrs := errs.Translate(nlTrans)
+	Equal(t, terrs["Test.Code"], "Code est trop court")
+
+	missing := validate.MissingTranslations(frTrans)
+	Equal(t, slices.Contains(missing, "required"), false)
+	Equal(t, slices.Contains(missing, "min"), false)
+	Equal(t, slices.Contains(missing, "max"), true)
+	Equal(t, slices.Contains(missing, "iscolor"), true)
+	Equal(t, slices.IsSorted(missing), true)
+	Equal(t, len(missing), len(validate.validations)+len(validate.aliases)-2)
+
+	Equal(t, validate.MissingTranslations(nlTrans2), validate.MissingTranslations(nlTrans))
+
+	// clones keep their fallbacks independently
+	c := validate.Clone()
+	c.RegisterTranslationFallback(nlTrans, enTrans)
+	Equal(t, errs[1].Translate(nlTrans), "Code est trop court")
+
+	errs = c.Struct(Test{Code: "a"}).(ValidationErrors)
+	Equal(t, errs[1].Translate(nlTrans), "Code is too short")
+}
+
+func TestTranslationFallbackChain(t *testing.T) {
+	uni := ut.New(en.New(), en.New(), fr.New(), fr_CA.New())
+
+	enTrans, _ := uni.GetTranslator("en")
+	frTrans, _ := uni.GetTranslator("fr")
+	frCATrans, _ := uni.GetTranslator("fr_CA")
+
+	validate := New(WithTranslators(uni))
+
+	register := func(tag string, trans ut.Translator, text string) {
+		err := validate.RegisterTranslation(tag, trans,
+			func(ut ut.Translator) error {
+				return ut.Add(tag, text, false)
+			}, func(ut ut.Translator, fe FieldError) string {
+				s, _ := ut.T(fe.Tag(), fe.Field())
+				return s
+			})
+		Equal(t, err, nil)
+	}
+
+	register("required", frCATrans, "{0} est requis")
+	register("required", frTrans, "{0} est un champ obligatoire")
+	register("min", frTrans, "{0} est trop court")
+	register("required", enTrans, "{0} is a required field")
+	register("min", enTrans, "{0} is too short")
+	register("max", enTrans, "{0} is too long")
+
+	type Test struct {
+		Name  string `validate:"required"`
+		Code  string `validate:"min=5"`
+		Label string `validate:"max=1"`
+		Email string `validate:"omitempty,email"`
+	}
+
+	errs := validate.Struct(Test{Code: "a", Label: "ab", Email: "x"}).(ValidationErrors)
+	Equal(t, len(errs), 4)
+
+	// fr_CA falls back to its parent locale fr and then to en, the fallback translator
+	Equal(t, errs[0].Translate(frCATrans), "Name est requis")
+	Equal(t, errs[1].Translate(frCATrans), "Code est trop court")
+	Equal(t, errs[2].Translate(frCATrans), "Label is too long")
+	Equal(t, errs[3].Translate(frCATrans), "Key: 'Test.Email' Error:Field validation for 'Email' failed on the 'email' tag")
+	Equal(t, errs[2].Translate(frTrans), "Label is too long")
+
+	// registered fallbacks replace the parent locale
+	validate.RegisterTranslationFallback(frCATrans, enTrans)
+	Equal(t, errs[1].Translate(frCATrans), "Code is too short")
+}
+func TestTranslateCtx(t *testing.T) {
+	uni := ut.New(en.New(), en.New(), fr.New(), fr_CA.New())
+
+	enTrans, _ := uni.GetTranslator("en")
+	frTrans, _ := uni.GetTranslator("fr")
+
+	type tenantKey struct{}
+	type userLocaleKey struct{}
+
+	register := func(v *Validate, trans ut.Translator, text string) {
+		err := v.RegisterTranslationCtx("required", trans,
+			func(ut ut.Translator) error {
+				return ut.Add("required", text, true)
+			}, func(ctx context.Context, ut ut.Translator, fe FieldError) string {
+				s, _ := ut.T(fe.Tag(), fe.Field())
+				if tenant, ok := ctx.Value(tenantKey{}).(string); ok {
+					s = tenant + ": " + s
+				}
+				return s
+			})
+		Equal(t, err, nil)
+	}
+
+	type Test struct {
+		Name string `validate:"required"`
+	}
+
+	// translators translations were registered for
+	validate := New()
+	register(validate, enTrans, "{0} is a required field")
+	register(validate, frTrans, "{0} est un champ obligatoire")
+
+	errs := validate.Struct(Test{}).(ValidationErrors)
+
+	Equal(t, errs.TranslateCtx(ContextWithLocales(context.Background(), "de", "fr-CA")), ValidationErrorsTranslations{
+		"Test.Name": "Name est un champ obligatoire",
+	})
+	Equal(t, TranslateCtx(context.Background(), errs[0]), errs[0].Error())
+	Equal(t, TranslateCtx(ContextWithLocales(context.Background(), "de"), errs[0]), errs[0].Error())
+
+	ctx := context.WithValue(ContextWithLocales(context.Background(), "en"), tenantKey{}, "acme")
+	Equal(t, TranslateCtx(ctx, errs[0]), "acme: Name is a required field")
+	Equal(t, errs[0].Translate(enTrans), "Name is a required field")
+
+	// translators of uni, falling back to uni's fallback
+	validate = New(WithTranslators(uni), WithLocaleResolver(func(ctx context.Context) []string {
+		locale, _ := ctx.Value(userLocaleKey{}).(string)
+		return []string{locale}
+	}))
+	register(validate, enTrans, "{0} is a required field")
+	register(validate, frTrans, "{0} est un champ obligatoire")
+
+	frCATrans, _ := uni.GetTranslator("fr_CA")
+	validate.RegisterTranslationFallback(frCATrans, frTrans)
+
+	errs = validate.Struct(Test{}).(ValidationErrors)
+
+	frCA := context.WithValue(context.Background(), userLocaleKey{}, "fr_CA")
+	Equal(t, validate.TranslatorCtx(frCA).Locale(), "fr_CA")
+	Equal(t, TranslateCtx(frCA, errs[0]), "Name est un champ obligatoire")
+	Equal(t, TranslateCtx(context.WithValue(frCA, tenantKey{}, "acme"), errs[0]), "acme: Name est un champ obligatoire")
+	Equal(t, validate.TranslatorCtx(context.Background()).Locale(), "en")
+	Equal(t, validate.Clone().TranslatorCtx(frCA).Locale(), "fr_CA")
+
+	trans, found := FindTranslator([]string{"de", "fr-BE"}, uni.GetTranslator)
+	Equal(t, found, true)
+	Equal(t, trans.Locale(), "fr")
+
+	_, found = FindTranslator([]string{"de"}, uni.GetTranslator)
+	Equal(t, found, false)
+}
+
+func TestFieldLabels(t *testing.T) {
+	uni := ut.New(en.New(), en.New(), fr.New(), fr_CA.New(), nl.New())
+
+	enTrans, _ := uni.GetTranslator("en")
+	frTrans, _ := uni.GetTranslator("fr")
+	frCATrans, _ := uni.GetTranslator("fr_CA")
+	nlTrans, _ := uni.GetTranslator("nl")
+
+	type Address struct {
+		City string `validate:"required" label_fr:"Ville" label_fr_CA:"Municipalité"`
+	}
+
+	type User struct {
+		Name    string   `json:"name" validate:"required" label_fr:"Nom" label_nl:"Naam"`
+		Emails  []string `json:"emails" validate:"dive,required" label_fr:"Courriel"`
+		Code    string   `json:"code" validate:"len=2"`
+		Address Address  `json:"address"`
+	}
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return fld.Tag.Get("json")
+	})
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(nil, "name", "Name", "unique", "")
+	}, User{})
+
+	Equal(t, validate.RegisterTranslation("required", frTrans, func(ut ut.Translator) error {
+		return ut.Add("required", "{0} est obligatoire", false)
+	}, func(ut ut.Translator, fe FieldError) string {
+		s, _ := ut.T("required", FieldLabel(fe, ut))
+		return s
+	}), nil)
+
+	u := User{Emails: []string{""}}
+
+	errs := validate.Struct(u).(ValidationErrors)
+	Equal(t, len(errs), 5)
+
+	Equal(t, FieldLabel(errs[0], frTrans), "Nom")
+	Equal(t, FieldLabel(errs[0], nlTrans), "Naam")
+	Equal(t, FieldLabel(errs[0], enTrans), "name")
+	Equal(t, FieldLabel(errs[0], nil), "name")
+	Equal(t, errs[0].Translate(frTrans), "Nom est obligatoire")
+
+	// elements use the label of their field
+	Equal(t, errs[1].Namespace(), "User.emails[0]")
+	Equal(t, FieldLabel(errs[1], frTrans), "Courriel")
+
+	Equal(t, FieldLabel(errs[2], frTrans), "code")
+
+	// labels of the locale take precedence over those of its language
+	Equal(t, FieldLabel(errs[3], frTrans), "Ville")
+	Equal(t, FieldLabel(errs[3], frCATrans), "Municipalité")
+
+	// the language's label is used for its other locales
+	Equal(t, FieldLabel(errs[0], frCATrans), "Nom")
+
+	// struct level errors use the label of the field reported
+	Equal(t, errs[4].Tag(), "unique")
+	Equal(t, FieldLabel(errs[4], frTrans), "Nom")
+
+	// registered labels take precedence over tags and apply to cached structs
+	validate.RegisterFieldLabel(frTrans, &User{}, "Name", "Nom complet")
+	validate.RegisterFieldLabel(enTrans, User{}, "Code", "Code postal")
+
+	errs = validate.Struct(u).(ValidationErrors)
+	Equal(t, FieldLabel(errs[0], frTrans), "Nom complet")
+	Equal(t, FieldLabel(errs[0], nlTrans), "Naam")
+	Equal(t, FieldLabel(errs[2], enTrans), "Code postal")
+	Equal(t, FieldLabel(errs[2], frTrans), "code")
+
+	c := validate.Clone()
+	c.RegisterFieldLabel(frTrans, User{}, "Name", "Prénom")
+
+	errs = validate.Struct(u).(ValidationErrors)
+	Equal(t, FieldLabel(errs[0], frTrans), "Nom complet")
+
+	errs = c.Struct(u).(ValidationErrors)
+	Equal(t, FieldLabel(errs[0], frTrans), "Prénom")
+
+	Equal(t, labelTags(`json:"a" label_de:"Vor\"name" label_:"x" label_fr:"Prénom"`), map[string]string{"de": `Vor"name`, "fr": "Prénom"})
+	Equal(t, labelTags(`json:"a" validate:"required"`) == nil, true)
+	Equal(t, labelTags(`label_de:"unterminated`) == nil, true)
+}
+
+type secret string
+
+func TestSensitive(t *testing.T) {
+	type Account struct {
+		Name     string            `validate:"min=3"`
+		Password string            `validate:"min=12" sensitive:"true"`
+		PINs     []string          `validate:"dive,len=4" sensitive:"true"`
+		Tokens   map[string]string `validate:"dive,keys,len=2,endkeys,required" sensitive:"true"`
+		Secret   secret            `validate:"min=8"`
+		Key      *secret           `validate:"required"`
+		Ignored  string            `sensitive:"true"`
+		Choice   string            `validate:"oneof=a0x2Csensitive b"`
+	}
+
+	var seen string
+
+	validate := New()
+	validate.RegisterSensitiveType(secret(""))
+	Equal(t, validate.RegisterValidation("notpassword", func(fl FieldLevel) bool {
+		seen = fl.Field().String()
+		return seen != "password"
+	}), nil)
+
+	a := Account{
+		Name:     "ab",
+		Password: "hunter2",
+		PINs:     []string{"1234", "12345"},
+		Tokens:   map[string]string{"abc": "", "ab": "token"},
+		Secret:   "s3cr",
+		Ignored:  "x",
+		Choice:   "b",
+	}
+
+	errs := validate.Struct(a).(ValidationErrors)
+	Equal(t, len(errs), 7)
+
+	Equal(t, errs[0].Value(), "ab")
+
+	for _, fe := range errs[1:] {
+		Equal(t, fe.Value(), RedactedValue)
+		Equal(t, strings.Contains(fe.Error(), "hunter2"), false)
+	}
+
+	Equal(t, errs[1].Namespace(), "Account.Password")
+	Equal(t, errs[2].Namespace(), "Account.PINs[1]")
+	Equal(t, errs[5].Namespace(), "Account.Secret")
+	Equal(t, errs[6].Namespace(), "Account.Key")
+
+	b, err := json.Marshal(errs)
+	Equal(t, err, nil)
+	Equal(t, strings.Contains(string(b), "hunter2"), false)
+	Equal(t, strings.Contains(string(b), "12345"), false)
+
+	b, err = json.Marshal(errs[:2])
+	Equal(t, err, nil)
+	Equal(t, string(b), `[{"namespace":"Account.Name","field":"Name","tag":"min","param":"3"},`+
+		`{"namespace":"Account.Password","field":"Password","tag":"min","param":"12"}]`)
+
+	// translations are passed the redacted value
+	uni := ut.New(en.New(), en.New())
+	trans, _ := uni.GetTranslator("en")
+
+	Equal(t, validate.RegisterTranslation("min", trans, func(ut ut.Translator) error {
+		return ut.Add("min", "{0} of {1} is too short", false)
+	}, func(ut ut.Translator, fe FieldError) string {
+		s, _ := ut.T(fe.Tag(), fe.Field(), fmt.Sprint(fe.Value()))
+		return s
+	}), nil)
+
+	Equal(t, errs[0].Translate(trans), "Name of ab is too short")
+	Equal(t, errs[1].Translate(trans), "Password of [REDACTED] is too short")
+
+	// validations see the actual value
+	errs = validate.Var(secret("password"), "notpassword").(ValidationErrors)
+	Equal(t, seen, "password")
+	Equal(t, errs[0].Value(), RedactedValue)
+
+	Equal(t, validate.Var("password", "notpassword").(ValidationErrors)[0].Value(), "password")
+
+	// an escaped comma within a param does not mark the field sensitive
+	errs = validate.Struct(Account{Name: "abc", Password: "hunter2hunter2", Secret: "secretsecret", Key: new(secret), Choice: "c"}).(ValidationErrors)
+	Equal(t, len(errs), 1)
+	Equal(t, errs[0].Tag(), "oneof")
+	Equal(t, errs[0].Value(), "c")
+
+	// struct level errors of sensitive fields
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(sl.Current().Interface().(Account).Password, "Password", "Password", "weak", "")
+		sl.ReportError(sl.Current().Interface().(Account).Name, "Name", "Name", "taken", "")
+	}, Account{})
+
+	errs = validate.Struct(Account{Name: "abc", Password: "hunter2hunter2", Secret: "secretsecret", Key: new(secret), Choice: "a,sensitive"}).(ValidationErrors)
+	Equal(t, len(errs), 2)
+	Equal(t, errs[0].Tag(), "weak")
+	Equal(t, errs[0].Value(), RedactedValue)
+	Equal(t, errs[1].Value(), "abc")
+
+	// sensitive types of a clone
+	c := New()
+	Equal(t, c.Var(secret("s"), "min=2").(ValidationErrors)[0].Value(), secret("s"))
+
+	c = validate.Clone()
+	Equal(t, c.Var(secret("s"), "min=2").(ValidationErrors)[0].Value(), RedactedValue)
+
+	// sensitive remains free as a validation name
+	c = New()
+	Equal(t, c.RegisterValidation("sensitive", func(fl FieldLevel) bool {
+		return fl.Field().String() != "hunter2"
+	}), nil)
+	errs = c.Var("hunter2", "sensitive").(ValidationErrors)
+	Equal(t, errs[0].Tag(), "sensitive")
+	Equal(t, errs[0].Value(), "hunter2")
+
+	// errors of fields holding sensitive values are redacted
+	type Login struct {
+		User     string
+		Password string `sensitive:"true"`
+	}
+
+	type Session struct {
+		Mode   string
+		Login  *Login           `validate:"required,excluded_if=Mode anonymous"`
+		Logins []Login          `validate:"max=1"`
+		Any    interface{}      `validate:"isdefault"`
+		Anys   []interface{}    `validate:"max=1"`
+		Keys   map[string]Login `validate:"required"`
+	}
+
+	validate = New()
+	errs = validate.Struct(Session{
+		Mode:   "anonymous",
+		Login:  &Login{User: "joe", Password: "hunter2"},
+		Logins: []Login{{Password: "hunter2"}, {}},
+		Any:    Login{Password: "hunter2"},
+		Anys:   []interface{}{"joe", &Login{Password: "hunter2"}},
+	}).(ValidationErrors)
+	Equal(t, len(errs), 5)
+
+	for _, fe := range errs {
+		Equal(t, fe.Value(), RedactedValue)
+	}
+
+	Equal(t, errs[0].Tag(), "excluded_if")
+	Equal(t, errs[1].Tag(), "max")
+	Equal(t, errs[2].Tag(), "isdefault")
+	Equal(t, errs[3].Namespace(), "Session.Anys")
+	Equal(t, errs[4].Tag(), "required")
+
+	b, err = json.Marshal(errs)
+	Equal(t, err, nil)
+	Equal(t, strings.Contains(string(b), "hunter2"), false)
+
+	Equal(t, validate.Var([]Login{{}}, "len=2").(ValidationErrors)[0].Value(), RedactedValue)
+	Equal(t, validate.Var([]string{"a"}, "len=2").(ValidationErrors)[0].Value(), []string{"a"})
+
+	// values held by interfaces are redacted only when sensitive
+	Equal(t, validate.Var([]interface{}{Login{}}, "len=2").(ValidationErrors)[0].Value(), RedactedValue)
+	Equal(t, validate.Var(map[string]interface{}{"a": &Login{}}, "len=2").(ValidationErrors)[0].Value(), RedactedValue)
+	Equal(t, validate.Var([]interface{}{"a"}, "len=2").(ValidationErrors)[0].Value(), []interface{}{"a"})
+
+	cycle := []interface{}{nil}
+	cycle[0] = cycle
+	_, redacted := validate.Var(cycle, "len=2").(ValidationErrors)[0].Value().(string)
+	Equal(t, redacted, false)
+}